@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.1
+// 	protoc-gen-go v1.36.3
 // 	protoc        v5.29.2
 // source: example.proto
 
@@ -8,9 +8,15 @@ package example
 
 import (
 	identifier "github.com/terwey/protoc-gen-go-options/example/identifier"
+	_ "github.com/terwey/protoc-gen-go-options/gooptions"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -22,6 +28,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Priority int32
+
+const (
+	Priority_PRIORITY_UNSPECIFIED Priority = 0
+	Priority_PRIORITY_LOW         Priority = 1
+	Priority_PRIORITY_HIGH        Priority = 2
+)
+
+// Enum value maps for Priority.
+var (
+	Priority_name = map[int32]string{
+		0: "PRIORITY_UNSPECIFIED",
+		1: "PRIORITY_LOW",
+		2: "PRIORITY_HIGH",
+	}
+	Priority_value = map[string]int32{
+		"PRIORITY_UNSPECIFIED": 0,
+		"PRIORITY_LOW":         1,
+		"PRIORITY_HIGH":        2,
+	}
+)
+
+func (x Priority) Enum() *Priority {
+	p := new(Priority)
+	*p = x
+	return p
+}
+
+func (x Priority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Priority) Descriptor() protoreflect.EnumDescriptor {
+	return file_example_proto_enumTypes[0].Descriptor()
+}
+
+func (Priority) Type() protoreflect.EnumType {
+	return &file_example_proto_enumTypes[0]
+}
+
+func (x Priority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Priority.Descriptor instead.
+func (Priority) EnumDescriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{0}
+}
+
 type FooBarWithEnum_Status int32
 
 const (
@@ -55,11 +110,11 @@ func (x FooBarWithEnum_Status) String() string {
 }
 
 func (FooBarWithEnum_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_example_proto_enumTypes[0].Descriptor()
+	return file_example_proto_enumTypes[1].Descriptor()
 }
 
 func (FooBarWithEnum_Status) Type() protoreflect.EnumType {
-	return &file_example_proto_enumTypes[0]
+	return &file_example_proto_enumTypes[1]
 }
 
 func (x FooBarWithEnum_Status) Number() protoreflect.EnumNumber {
@@ -528,11 +583,9 @@ func (x *SomeMessage) GetInclude() []*identifier.Identifier {
 }
 
 // If you don't want to generate a New function for a message,
-// you can add the GO_OPTIONS_SKIP_INIT option as somewhere
-// in the leading comments of the message.
+// you can set the skip_init custom option on the message.
 // This is useful if you already have an existing New function
 // but still want to generate the functions that call to it.
-// GO_OPTIONS_SKIP_INIT
 type NoInit struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	NoInitName    *string                `protobuf:"bytes,1,opt,name=noInitName" json:"noInitName,omitempty"`
@@ -661,11 +714,9 @@ func (x *FooBarWithEnum) GetStatus() FooBarWithEnum_Status {
 type JsonExample struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// In case the message should be JSON-marshalable for persistence
-	// you can add the GO_OPTIONS_JSON_PERSISTENT option in the
-	// leading comment of the field.
+	// you can set the json_persistent custom option on the field.
 	// This will generate a GetFieldnameAsJSON and SetFieldnameFromJSON
 	// function on the message.
-	// GO_OPTIONS_JSON_PERSISTENT
 	Basic         *BasicMessage `protobuf:"bytes,1,opt,name=basic" json:"basic,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -755,6 +806,10 @@ func (x *Primitives) GetInteger64() int64 {
 type WellKnown struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	MaxRetries    *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=max_retries,json=maxRetries" json:"max_retries,omitempty"`
+	Attributes    *structpb.Struct       `protobuf:"bytes,3,opt,name=attributes" json:"attributes,omitempty"`
+	Attachment    *anypb.Any             `protobuf:"bytes,4,opt,name=attachment" json:"attachment,omitempty"`
+	Ping          *emptypb.Empty         `protobuf:"bytes,5,opt,name=ping" json:"ping,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -796,161 +851,1355 @@ func (x *WellKnown) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-var File_example_proto protoreflect.FileDescriptor
+func (x *WellKnown) GetMaxRetries() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return nil
+}
 
-var file_example_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x1a, 0x10, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x51, 0x0a, 0x0c, 0x42,
-	0x61, 0x73, 0x69, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x10, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x61, 0x67,
-	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x43,
-	0x0a, 0x15, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x22, 0x5e, 0x0a, 0x0d, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x69, 0x63, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x42, 0x61,
-	0x73, 0x69, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x62, 0x61, 0x73, 0x69,
-	0x63, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x48, 0x0a, 0x0c, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x22, 0xf9, 0x01,
-	0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x2e, 0x0a, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65,
-	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64,
-	0x12, 0x37, 0x0a, 0x0b, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0a, 0x6e,
-	0x65, 0x73, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x78, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2d, 0x0a, 0x03, 0x46, 0x6f, 0x6f,
-	0x12, 0x26, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2d, 0x0a, 0x03, 0x42, 0x61, 0x72, 0x12,
-	0x26, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x52, 0x02, 0x69, 0x64, 0x22, 0x77, 0x0a, 0x0b, 0x53, 0x6f, 0x6d, 0x65, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x30,
-	0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
-	0x22, 0x28, 0x0a, 0x06, 0x4e, 0x6f, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x6f,
-	0x49, 0x6e, 0x69, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x6e, 0x6f, 0x49, 0x6e, 0x69, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x0e, 0x0a, 0x0c, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x79, 0x0a, 0x0e, 0x46, 0x6f,
-	0x6f, 0x42, 0x61, 0x72, 0x57, 0x69, 0x74, 0x68, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x36, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x46, 0x6f, 0x6f, 0x42, 0x61, 0x72, 0x57, 0x69, 0x74,
-	0x68, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x2f, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b,
-	0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41,
-	0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x41, 0x43, 0x54,
-	0x49, 0x56, 0x45, 0x10, 0x02, 0x22, 0x3a, 0x0a, 0x0b, 0x4a, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x69, 0x63, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x42, 0x61,
-	0x73, 0x69, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x62, 0x61, 0x73, 0x69,
-	0x63, 0x22, 0x2a, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12,
-	0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x36, 0x34, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x36, 0x34, 0x22, 0x46, 0x0a,
-	0x09, 0x57, 0x65, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x72, 0x77, 0x65, 0x79, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x3b, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x62, 0x08, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x70, 0xe8, 0x07,
+func (x *WellKnown) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
 }
 
-var (
-	file_example_proto_rawDescOnce sync.Once
-	file_example_proto_rawDescData = file_example_proto_rawDesc
-)
+func (x *WellKnown) GetAttachment() *anypb.Any {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
 
-func file_example_proto_rawDescGZIP() []byte {
-	file_example_proto_rawDescOnce.Do(func() {
-		file_example_proto_rawDescData = protoimpl.X.CompressGZIP(file_example_proto_rawDescData)
-	})
-	return file_example_proto_rawDescData
+func (x *WellKnown) GetPing() *emptypb.Empty {
+	if x != nil {
+		return x.Ping
+	}
+	return nil
 }
 
-var file_example_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_example_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
-var file_example_proto_goTypes = []any{
-	(FooBarWithEnum_Status)(0),    // 0: example.FooBarWithEnum.Status
-	(*BasicMessage)(nil),          // 1: example.BasicMessage
-	(*RepeatedFieldsMessage)(nil), // 2: example.RepeatedFieldsMessage
-	(*NestedMessage)(nil),         // 3: example.NestedMessage
-	(*OneofMessage)(nil),          // 4: example.OneofMessage
-	(*ComplexMessage)(nil),        // 5: example.ComplexMessage
-	(*Foo)(nil),                   // 6: example.Foo
-	(*Bar)(nil),                   // 7: example.Bar
-	(*SomeMessage)(nil),           // 8: example.SomeMessage
-	(*NoInit)(nil),                // 9: example.NoInit
-	(*EmptyMessage)(nil),          // 10: example.EmptyMessage
-	(*FooBarWithEnum)(nil),        // 11: example.FooBarWithEnum
-	(*JsonExample)(nil),           // 12: example.JsonExample
-	(*Primitives)(nil),            // 13: example.Primitives
-	(*WellKnown)(nil),             // 14: example.WellKnown
-	nil,                           // 15: example.ComplexMessage.MetadataEntry
-	(*identifier.Identifier)(nil), // 16: identifier.Identifier
-	(*timestamppb.Timestamp)(nil), // 17: google.protobuf.Timestamp
+// Messages declared inside other messages get their own options as well.
+type Envelope struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Header        *Envelope_Header       `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Forwarded     []*Envelope_Header     `protobuf:"bytes,2,rep,name=forwarded" json:"forwarded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
-var file_example_proto_depIdxs = []int32{
-	1,  // 0: example.NestedMessage.basic:type_name -> example.BasicMessage
-	3,  // 1: example.ComplexMessage.nested:type_name -> example.NestedMessage
-	3,  // 2: example.ComplexMessage.nested_list:type_name -> example.NestedMessage
-	15, // 3: example.ComplexMessage.metadata:type_name -> example.ComplexMessage.MetadataEntry
-	16, // 4: example.Foo.id:type_name -> identifier.Identifier
-	16, // 5: example.Bar.id:type_name -> identifier.Identifier
-	16, // 6: example.SomeMessage.identifier:type_name -> identifier.Identifier
-	16, // 7: example.SomeMessage.include:type_name -> identifier.Identifier
-	0,  // 8: example.FooBarWithEnum.status:type_name -> example.FooBarWithEnum.Status
-	1,  // 9: example.JsonExample.basic:type_name -> example.BasicMessage
-	17, // 10: example.WellKnown.created_at:type_name -> google.protobuf.Timestamp
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+
+func (x *Envelope) Reset() {
+	*x = Envelope{}
+	mi := &file_example_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func init() { file_example_proto_init() }
-func file_example_proto_init() {
-	if File_example_proto != nil {
-		return
+func (x *Envelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Envelope) ProtoMessage() {}
+
+func (x *Envelope) ProtoReflect() protoreflect.Message {
+	mi := &file_example_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	file_example_proto_msgTypes[3].OneofWrappers = []any{
-		(*OneofMessage_Text)(nil),
-		(*OneofMessage_Number)(nil),
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Envelope.ProtoReflect.Descriptor instead.
+func (*Envelope) Descriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Envelope) GetHeader() *Envelope_Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *Envelope) GetForwarded() []*Envelope_Header {
+	if x != nil {
+		return x.Forwarded
+	}
+	return nil
+}
+
+// Fields and oneofs can be left out of the generated options.
+type SkipExample struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Kept     *string                `protobuf:"bytes,1,opt,name=kept" json:"kept,omitempty"`
+	Internal *string                `protobuf:"bytes,2,opt,name=internal" json:"internal,omitempty"`
+	// Types that are valid to be assigned to Mode:
+	//
+	//	*SkipExample_Fast
+	//	*SkipExample_Slow
+	Mode          isSkipExample_Mode `protobuf_oneof:"mode"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipExample) Reset() {
+	*x = SkipExample{}
+	mi := &file_example_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipExample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipExample) ProtoMessage() {}
+
+func (x *SkipExample) ProtoReflect() protoreflect.Message {
+	mi := &file_example_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipExample.ProtoReflect.Descriptor instead.
+func (*SkipExample) Descriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SkipExample) GetKept() string {
+	if x != nil && x.Kept != nil {
+		return *x.Kept
+	}
+	return ""
+}
+
+func (x *SkipExample) GetInternal() string {
+	if x != nil && x.Internal != nil {
+		return *x.Internal
+	}
+	return ""
+}
+
+func (x *SkipExample) GetMode() isSkipExample_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return nil
+}
+
+func (x *SkipExample) GetFast() string {
+	if x != nil {
+		if x, ok := x.Mode.(*SkipExample_Fast); ok {
+			return x.Fast
+		}
+	}
+	return ""
+}
+
+func (x *SkipExample) GetSlow() string {
+	if x != nil {
+		if x, ok := x.Mode.(*SkipExample_Slow); ok {
+			return x.Slow
+		}
+	}
+	return ""
+}
+
+type isSkipExample_Mode interface {
+	isSkipExample_Mode()
+}
+
+type SkipExample_Fast struct {
+	Fast string `protobuf:"bytes,3,opt,name=fast,oneof"`
+}
+
+type SkipExample_Slow struct {
+	Slow string `protobuf:"bytes,4,opt,name=slow,oneof"`
+}
+
+func (*SkipExample_Fast) isSkipExample_Mode() {}
+
+func (*SkipExample_Slow) isSkipExample_Mode() {}
+
+// Fields without presence, like plain proto3 scalars, are set directly instead of through a pointer.
+type ImplicitPresence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label" json:"label,omitempty"`
+	Count         uint32                 `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,3,opt,name=payload" json:"payload,omitempty"`
+	Priority      Priority               `protobuf:"varint,4,opt,name=priority,enum=example.Priority" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImplicitPresence) Reset() {
+	*x = ImplicitPresence{}
+	mi := &file_example_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImplicitPresence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImplicitPresence) ProtoMessage() {}
+
+func (x *ImplicitPresence) ProtoReflect() protoreflect.Message {
+	mi := &file_example_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImplicitPresence.ProtoReflect.Descriptor instead.
+func (*ImplicitPresence) Descriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ImplicitPresence) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ImplicitPresence) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ImplicitPresence) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImplicitPresence) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+// Oneof members that are messages can be built from their options as well.
+type Schedule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to When:
+	//
+	//	*Schedule_At
+	//	*Schedule_Every
+	//	*Schedule_Owner
+	When          isSchedule_When                 `protobuf_oneof:"when"`
+	EventTimes    []*timestamppb.Timestamp        `protobuf:"bytes,4,rep,name=event_times,json=eventTimes" json:"event_times,omitempty"`
+	Timeouts      map[string]*durationpb.Duration `protobuf:"bytes,5,rep,name=timeouts" json:"timeouts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	mi := &file_example_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_example_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Schedule) GetWhen() isSchedule_When {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *Schedule) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		if x, ok := x.When.(*Schedule_At); ok {
+			return x.At
+		}
+	}
+	return nil
+}
+
+func (x *Schedule) GetEvery() *durationpb.Duration {
+	if x != nil {
+		if x, ok := x.When.(*Schedule_Every); ok {
+			return x.Every
+		}
+	}
+	return nil
+}
+
+func (x *Schedule) GetOwner() *BasicMessage {
+	if x != nil {
+		if x, ok := x.When.(*Schedule_Owner); ok {
+			return x.Owner
+		}
+	}
+	return nil
+}
+
+func (x *Schedule) GetEventTimes() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.EventTimes
+	}
+	return nil
+}
+
+func (x *Schedule) GetTimeouts() map[string]*durationpb.Duration {
+	if x != nil {
+		return x.Timeouts
+	}
+	return nil
+}
+
+type isSchedule_When interface {
+	isSchedule_When()
+}
+
+type Schedule_At struct {
+	At *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=at,oneof"`
+}
+
+type Schedule_Every struct {
+	Every *durationpb.Duration `protobuf:"bytes,2,opt,name=every,oneof"`
+}
+
+type Schedule_Owner struct {
+	Owner *BasicMessage `protobuf:"bytes,3,opt,name=owner,oneof"`
+}
+
+func (*Schedule_At) isSchedule_When() {}
+
+func (*Schedule_Every) isSchedule_When() {}
+
+func (*Schedule_Owner) isSchedule_When() {}
+
+type ProtojsonExample struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The json option of the field (or file) switches the JSON persistence
+	// methods to protojson, so int64 values are encoded as strings and enums
+	// by their name.
+	Basic         *BasicMessage `protobuf:"bytes,1,opt,name=basic" json:"basic,omitempty"`
+	Ids           []int64       `protobuf:"varint,2,rep,packed,name=ids" json:"ids,omitempty"`
+	Level         *Priority     `protobuf:"varint,3,opt,name=level,enum=example.Priority" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProtojsonExample) Reset() {
+	*x = ProtojsonExample{}
+	mi := &file_example_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProtojsonExample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtojsonExample) ProtoMessage() {}
+
+func (x *ProtojsonExample) ProtoReflect() protoreflect.Message {
+	mi := &file_example_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtojsonExample.ProtoReflect.Descriptor instead.
+func (*ProtojsonExample) Descriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ProtojsonExample) GetBasic() *BasicMessage {
+	if x != nil {
+		return x.Basic
+	}
+	return nil
+}
+
+func (x *ProtojsonExample) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ProtojsonExample) GetLevel() Priority {
+	if x != nil && x.Level != nil {
+		return *x.Level
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+type PersistenceExample struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Besides JSON a field can be persisted in the protobuf binary format,
+	// the text format or base64 of the binary format.
+	Blob          *BasicMessage `protobuf:"bytes,1,opt,name=blob" json:"blob,omitempty"`
+	Config        *BasicMessage `protobuf:"bytes,2,opt,name=config" json:"config,omitempty"`
+	Token         *BasicMessage `protobuf:"bytes,3,opt,name=token" json:"token,omitempty"`
+	Names         []string      `protobuf:"bytes,4,rep,name=names" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersistenceExample) Reset() {
+	*x = PersistenceExample{}
+	mi := &file_example_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersistenceExample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistenceExample) ProtoMessage() {}
+
+func (x *PersistenceExample) ProtoReflect() protoreflect.Message {
+	mi := &file_example_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistenceExample.ProtoReflect.Descriptor instead.
+func (*PersistenceExample) Descriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PersistenceExample) GetBlob() *BasicMessage {
+	if x != nil {
+		return x.Blob
+	}
+	return nil
+}
+
+func (x *PersistenceExample) GetConfig() *BasicMessage {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *PersistenceExample) GetToken() *BasicMessage {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *PersistenceExample) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type SqlExample struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The sql option generates a SqlExampleDocumentSQL type implementing
+	// sql.Scanner and driver.Valuer, so the field can be passed straight to
+	// database/sql, e.g. for a jsonb column.
+	Document *BasicMessage `protobuf:"bytes,1,opt,name=document" json:"document,omitempty"`
+	// or for a bytea column.
+	Snapshot      *BasicMessage `protobuf:"bytes,2,opt,name=snapshot" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SqlExample) Reset() {
+	*x = SqlExample{}
+	mi := &file_example_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SqlExample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SqlExample) ProtoMessage() {}
+
+func (x *SqlExample) ProtoReflect() protoreflect.Message {
+	mi := &file_example_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SqlExample.ProtoReflect.Descriptor instead.
+func (*SqlExample) Descriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SqlExample) GetDocument() *BasicMessage {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *SqlExample) GetSnapshot() *BasicMessage {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+// The error_options option generates options returning an error next to the
+// regular ones, they reject invalid input such as an undeclared enum value
+// and are applied with NewValidatedE.
+type Validated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Severity      *Priority              `protobuf:"varint,1,opt,name=severity,enum=example.Priority" json:"severity,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=starts_at,json=startsAt" json:"starts_at,omitempty"`
+	Window        *Validated_Window      `protobuf:"bytes,3,opt,name=window" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Validated) Reset() {
+	*x = Validated{}
+	mi := &file_example_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Validated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Validated) ProtoMessage() {}
+
+func (x *Validated) ProtoReflect() protoreflect.Message {
+	mi := &file_example_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Validated.ProtoReflect.Descriptor instead.
+func (*Validated) Descriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Validated) GetSeverity() Priority {
+	if x != nil && x.Severity != nil {
+		return *x.Severity
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+func (x *Validated) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *Validated) GetWindow() *Validated_Window {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+// Fields with features.field_presence = LEGACY_REQUIRED, like proto2 required
+// fields, make proto.Marshal fail while they are unset. NewAccountChecked and
+// MustNewAccount check them, including those of the nested messages, after
+// the options are applied.
+type Account struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	AccountId           *string                `protobuf:"bytes,1,req,name=account_id,json=accountId" json:"account_id,omitempty"`
+	Credentials         *Account_Credentials   `protobuf:"bytes,2,opt,name=credentials" json:"credentials,omitempty"`
+	PreviousCredentials []*Account_Credentials `protobuf:"bytes,3,rep,name=previous_credentials,json=previousCredentials" json:"previous_credentials,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_example_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_example_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Account) GetAccountId() string {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return ""
+}
+
+func (x *Account) GetCredentials() *Account_Credentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *Account) GetPreviousCredentials() []*Account_Credentials {
+	if x != nil {
+		return x.PreviousCredentials
+	}
+	return nil
+}
+
+// Declared defaults are only returned by the getters while a field is unset.
+// NewRetryPolicyWithDefaults and WithDefaults store them in the message, so
+// they show up in the struct and survive serialization.
+type RetryPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attempts      *uint32                `protobuf:"varint,1,opt,name=attempts,def=3" json:"attempts,omitempty"`
+	Backoff       *string                `protobuf:"bytes,2,opt,name=backoff,def=exponential" json:"backoff,omitempty"`
+	Escalation    *Priority              `protobuf:"varint,3,opt,name=escalation,enum=example.Priority,def=2" json:"escalation,omitempty"`
+	Jitter        *float64               `protobuf:"fixed64,4,opt,name=jitter" json:"jitter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+// Default values for RetryPolicy fields.
+const (
+	Default_RetryPolicy_Attempts   = uint32(3)
+	Default_RetryPolicy_Backoff    = string("exponential")
+	Default_RetryPolicy_Escalation = Priority_PRIORITY_HIGH
+)
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	mi := &file_example_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_example_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RetryPolicy) GetAttempts() uint32 {
+	if x != nil && x.Attempts != nil {
+		return *x.Attempts
+	}
+	return Default_RetryPolicy_Attempts
+}
+
+func (x *RetryPolicy) GetBackoff() string {
+	if x != nil && x.Backoff != nil {
+		return *x.Backoff
+	}
+	return Default_RetryPolicy_Backoff
+}
+
+func (x *RetryPolicy) GetEscalation() Priority {
+	if x != nil && x.Escalation != nil {
+		return *x.Escalation
+	}
+	return Default_RetryPolicy_Escalation
+}
+
+func (x *RetryPolicy) GetJitter() float64 {
+	if x != nil && x.Jitter != nil {
+		return *x.Jitter
+	}
+	return 0
+}
+
+// The name option renames the options of a field. WithCustomerId and
+// WithoutCustomerId are never qualified with the message name, unlike
+// WithIdForFoo and WithIdForBar, so they stay the same when another message
+// adds an id field.
+type Customer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *identifier.Identifier `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Email         *string                `protobuf:"bytes,2,opt,name=email" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Customer) Reset() {
+	*x = Customer{}
+	mi := &file_example_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Customer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Customer) ProtoMessage() {}
+
+func (x *Customer) ProtoReflect() protoreflect.Message {
+	mi := &file_example_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Customer.ProtoReflect.Descriptor instead.
+func (*Customer) Descriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Customer) GetId() *identifier.Identifier {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *Customer) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+type Envelope_Header struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TraceId       *string                `protobuf:"bytes,1,opt,name=trace_id,json=traceId" json:"trace_id,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,2,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Envelope_Header) Reset() {
+	*x = Envelope_Header{}
+	mi := &file_example_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Envelope_Header) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Envelope_Header) ProtoMessage() {}
+
+func (x *Envelope_Header) ProtoReflect() protoreflect.Message {
+	mi := &file_example_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Envelope_Header.ProtoReflect.Descriptor instead.
+func (*Envelope_Header) Descriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *Envelope_Header) GetTraceId() string {
+	if x != nil && x.TraceId != nil {
+		return *x.TraceId
+	}
+	return ""
+}
+
+func (x *Envelope_Header) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type Validated_Window struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Length        *durationpb.Duration   `protobuf:"bytes,1,opt,name=length" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Validated_Window) Reset() {
+	*x = Validated_Window{}
+	mi := &file_example_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Validated_Window) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Validated_Window) ProtoMessage() {}
+
+func (x *Validated_Window) ProtoReflect() protoreflect.Message {
+	mi := &file_example_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Validated_Window.ProtoReflect.Descriptor instead.
+func (*Validated_Window) Descriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *Validated_Window) GetLength() *durationpb.Duration {
+	if x != nil {
+		return x.Length
+	}
+	return nil
+}
+
+type Account_Credentials struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *string                `protobuf:"bytes,1,req,name=user" json:"user,omitempty"`
+	Secret        *string                `protobuf:"bytes,2,opt,name=secret" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Account_Credentials) Reset() {
+	*x = Account_Credentials{}
+	mi := &file_example_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account_Credentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account_Credentials) ProtoMessage() {}
+
+func (x *Account_Credentials) ProtoReflect() protoreflect.Message {
+	mi := &file_example_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account_Credentials.ProtoReflect.Descriptor instead.
+func (*Account_Credentials) Descriptor() ([]byte, []int) {
+	return file_example_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *Account_Credentials) GetUser() string {
+	if x != nil && x.User != nil {
+		return *x.User
+	}
+	return ""
+}
+
+func (x *Account_Credentials) GetSecret() string {
+	if x != nil && x.Secret != nil {
+		return *x.Secret
+	}
+	return ""
+}
+
+var File_example_proto protoreflect.FileDescriptor
+
+var file_example_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x1a, 0x10, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x67, 0x6f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x6f, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x59, 0x0a,
+	0x0c, 0x42, 0x61, 0x73, 0x69, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x3a, 0x06, 0xa2, 0x86, 0x19, 0x02, 0x20, 0x01, 0x22, 0x43, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x66, 0x0a,
+	0x0d, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2b,
+	0x0a, 0x05, 0x62, 0x61, 0x73, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x62, 0x61, 0x73, 0x69, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x06, 0xa2,
+	0x86, 0x19, 0x02, 0x20, 0x01, 0x22, 0x48, 0x0a, 0x0c, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x22,
+	0x81, 0x02, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4e, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x6e, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x12, 0x37, 0x0a, 0x0b, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x0a, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x78, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b,
+	0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x06, 0xa2, 0x86, 0x19,
+	0x02, 0x20, 0x01, 0x22, 0x2d, 0x0a, 0x03, 0x46, 0x6f, 0x6f, 0x12, 0x26, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x2d, 0x0a, 0x03, 0x42, 0x61, 0x72, 0x12, 0x26, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x77, 0x0a, 0x0b, 0x53, 0x6f, 0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x36, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x22, 0x30, 0x0a, 0x06, 0x4e, 0x6f,
+	0x49, 0x6e, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x6f, 0x49, 0x6e, 0x69, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x6f, 0x49, 0x6e, 0x69, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x3a, 0x06, 0xa2, 0x86, 0x19, 0x02, 0x10, 0x01, 0x22, 0x0e, 0x0a, 0x0c,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x79, 0x0a, 0x0e,
+	0x46, 0x6f, 0x6f, 0x42, 0x61, 0x72, 0x57, 0x69, 0x74, 0x68, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x36,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x46, 0x6f, 0x6f, 0x42, 0x61, 0x72, 0x57,
+	0x69, 0x74, 0x68, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2f, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x41,
+	0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x22, 0x42, 0x0a, 0x0b, 0x4a, 0x73, 0x6f, 0x6e, 0x45,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x69, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x42, 0x61, 0x73, 0x69, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x06, 0xa2, 0x86,
+	0x19, 0x02, 0x08, 0x01, 0x52, 0x05, 0x62, 0x61, 0x73, 0x69, 0x63, 0x22, 0x2a, 0x0a, 0x0a, 0x50,
+	0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74,
+	0x65, 0x67, 0x65, 0x72, 0x36, 0x34, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x69, 0x6e,
+	0x74, 0x65, 0x67, 0x65, 0x72, 0x36, 0x34, 0x22, 0x9f, 0x02, 0x0a, 0x09, 0x57, 0x65, 0x6c, 0x6c,
+	0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x3c, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x37,
+	0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
+	0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a,
+	0x04, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x22, 0x93, 0x02, 0x0a, 0x08, 0x45, 0x6e,
+	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x09, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64,
+	0x1a, 0x9c, 0x01, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x81, 0x01, 0x0a, 0x0b, 0x53, 0x6b, 0x69, 0x70, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x65, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b,
+	0x65, 0x70, 0x74, 0x12, 0x22, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xa2, 0x86, 0x19, 0x02, 0x10, 0x01, 0x52, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x04, 0x66, 0x61, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x66, 0x61, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x04, 0x73, 0x6c, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x73,
+	0x6c, 0x6f, 0x77, 0x42, 0x0e, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x06, 0xa2, 0x86, 0x19,
+	0x02, 0x08, 0x01, 0x22, 0xa3, 0x01, 0x0a, 0x10, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74,
+	0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xf4, 0x02, 0x0a, 0x08, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2c, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00,
+	0x52, 0x02, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x76, 0x65, 0x72, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73,
+	0x1a, 0x56, 0x0a, 0x0d, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e,
+	0x22, 0xa4, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x6a, 0x73, 0x6f, 0x6e, 0x45, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x69, 0x63, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x42,
+	0x61, 0x73, 0x69, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x0a, 0xa2, 0x86, 0x19,
+	0x06, 0x08, 0x01, 0x1a, 0x02, 0x08, 0x01, 0x52, 0x05, 0x62, 0x61, 0x73, 0x69, 0x63, 0x12, 0x1c,
+	0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x42, 0x0a, 0xa2, 0x86, 0x19,
+	0x06, 0x08, 0x01, 0x1a, 0x02, 0x08, 0x01, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x10,
+	0xa2, 0x86, 0x19, 0x0c, 0x08, 0x01, 0x1a, 0x08, 0x08, 0x01, 0x10, 0x01, 0x18, 0x01, 0x20, 0x01,
+	0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xd3, 0x01, 0x0a, 0x12, 0x50, 0x65, 0x72, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x31,
+	0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x42, 0x06, 0xa2, 0x86, 0x19, 0x02, 0x20, 0x01, 0x52, 0x04, 0x62, 0x6c, 0x6f,
+	0x62, 0x12, 0x35, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x69,
+	0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x06, 0xa2, 0x86, 0x19, 0x02, 0x28, 0x01,
+	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x33, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x06,
+	0xa2, 0x86, 0x19, 0x02, 0x30, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a,
+	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x08, 0xa2, 0x86,
+	0x19, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x86, 0x01,
+	0x0a, 0x0a, 0x53, 0x71, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x08,
+	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x0a, 0xa2, 0x86, 0x19, 0x06, 0x1a, 0x02, 0x10, 0x01, 0x38,
+	0x01, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x42, 0x06, 0xa2, 0x86, 0x19, 0x02, 0x38, 0x02, 0x52, 0x08, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0xf3, 0x01, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x31, 0x0a, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x1a,
+	0x43, 0x0a, 0x06, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x31, 0x0a, 0x06, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x3a, 0x06, 0xa2, 0x86,
+	0x19, 0x02, 0x18, 0x01, 0x3a, 0x06, 0xa2, 0x86, 0x19, 0x02, 0x18, 0x01, 0x22, 0x82, 0x02, 0x0a,
+	0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0xaa, 0x01,
+	0x02, 0x08, 0x03, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3e,
+	0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x4f,
+	0x0a, 0x14, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x13, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x1a,
+	0x40, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x19,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0xaa, 0x01,
+	0x02, 0x08, 0x03, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x22, 0xad, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x1d, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x3a, 0x01, 0x33, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x12, 0x25, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x3a, 0x0b, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x07,
+	0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x40, 0x0a, 0x0a, 0x65, 0x73, 0x63, 0x61, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x3a, 0x0d,
+	0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x52, 0x0a, 0x65,
+	0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x22, 0x5a, 0x0a, 0x08, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x38, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x42, 0x10, 0xa2, 0x86, 0x19, 0x0c, 0x42, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2a, 0x49, 0x0a,
+	0x08, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x49,
+	0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f,
+	0x4c, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54,
+	0x59, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x10, 0x02, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x72, 0x77, 0x65, 0x79, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x3b, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x62, 0x08, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x70, 0xe8, 0x07,
+}
+
+var (
+	file_example_proto_rawDescOnce sync.Once
+	file_example_proto_rawDescData = file_example_proto_rawDesc
+)
+
+func file_example_proto_rawDescGZIP() []byte {
+	file_example_proto_rawDescOnce.Do(func() {
+		file_example_proto_rawDescData = protoimpl.X.CompressGZIP(file_example_proto_rawDescData)
+	})
+	return file_example_proto_rawDescData
+}
+
+var file_example_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_example_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_example_proto_goTypes = []any{
+	(Priority)(0),                 // 0: example.Priority
+	(FooBarWithEnum_Status)(0),    // 1: example.FooBarWithEnum.Status
+	(*BasicMessage)(nil),          // 2: example.BasicMessage
+	(*RepeatedFieldsMessage)(nil), // 3: example.RepeatedFieldsMessage
+	(*NestedMessage)(nil),         // 4: example.NestedMessage
+	(*OneofMessage)(nil),          // 5: example.OneofMessage
+	(*ComplexMessage)(nil),        // 6: example.ComplexMessage
+	(*Foo)(nil),                   // 7: example.Foo
+	(*Bar)(nil),                   // 8: example.Bar
+	(*SomeMessage)(nil),           // 9: example.SomeMessage
+	(*NoInit)(nil),                // 10: example.NoInit
+	(*EmptyMessage)(nil),          // 11: example.EmptyMessage
+	(*FooBarWithEnum)(nil),        // 12: example.FooBarWithEnum
+	(*JsonExample)(nil),           // 13: example.JsonExample
+	(*Primitives)(nil),            // 14: example.Primitives
+	(*WellKnown)(nil),             // 15: example.WellKnown
+	(*Envelope)(nil),              // 16: example.Envelope
+	(*SkipExample)(nil),           // 17: example.SkipExample
+	(*ImplicitPresence)(nil),      // 18: example.ImplicitPresence
+	(*Schedule)(nil),              // 19: example.Schedule
+	(*ProtojsonExample)(nil),      // 20: example.ProtojsonExample
+	(*PersistenceExample)(nil),    // 21: example.PersistenceExample
+	(*SqlExample)(nil),            // 22: example.SqlExample
+	(*Validated)(nil),             // 23: example.Validated
+	(*Account)(nil),               // 24: example.Account
+	(*RetryPolicy)(nil),           // 25: example.RetryPolicy
+	(*Customer)(nil),              // 26: example.Customer
+	nil,                           // 27: example.ComplexMessage.MetadataEntry
+	(*Envelope_Header)(nil),       // 28: example.Envelope.Header
+	nil,                           // 29: example.Envelope.Header.LabelsEntry
+	nil,                           // 30: example.Schedule.TimeoutsEntry
+	(*Validated_Window)(nil),      // 31: example.Validated.Window
+	(*Account_Credentials)(nil),   // 32: example.Account.Credentials
+	(*identifier.Identifier)(nil), // 33: identifier.Identifier
+	(*timestamppb.Timestamp)(nil), // 34: google.protobuf.Timestamp
+	(*wrapperspb.Int32Value)(nil), // 35: google.protobuf.Int32Value
+	(*structpb.Struct)(nil),       // 36: google.protobuf.Struct
+	(*anypb.Any)(nil),             // 37: google.protobuf.Any
+	(*emptypb.Empty)(nil),         // 38: google.protobuf.Empty
+	(*durationpb.Duration)(nil),   // 39: google.protobuf.Duration
+}
+var file_example_proto_depIdxs = []int32{
+	2,  // 0: example.NestedMessage.basic:type_name -> example.BasicMessage
+	4,  // 1: example.ComplexMessage.nested:type_name -> example.NestedMessage
+	4,  // 2: example.ComplexMessage.nested_list:type_name -> example.NestedMessage
+	27, // 3: example.ComplexMessage.metadata:type_name -> example.ComplexMessage.MetadataEntry
+	33, // 4: example.Foo.id:type_name -> identifier.Identifier
+	33, // 5: example.Bar.id:type_name -> identifier.Identifier
+	33, // 6: example.SomeMessage.identifier:type_name -> identifier.Identifier
+	33, // 7: example.SomeMessage.include:type_name -> identifier.Identifier
+	1,  // 8: example.FooBarWithEnum.status:type_name -> example.FooBarWithEnum.Status
+	2,  // 9: example.JsonExample.basic:type_name -> example.BasicMessage
+	34, // 10: example.WellKnown.created_at:type_name -> google.protobuf.Timestamp
+	35, // 11: example.WellKnown.max_retries:type_name -> google.protobuf.Int32Value
+	36, // 12: example.WellKnown.attributes:type_name -> google.protobuf.Struct
+	37, // 13: example.WellKnown.attachment:type_name -> google.protobuf.Any
+	38, // 14: example.WellKnown.ping:type_name -> google.protobuf.Empty
+	28, // 15: example.Envelope.header:type_name -> example.Envelope.Header
+	28, // 16: example.Envelope.forwarded:type_name -> example.Envelope.Header
+	0,  // 17: example.ImplicitPresence.priority:type_name -> example.Priority
+	34, // 18: example.Schedule.at:type_name -> google.protobuf.Timestamp
+	39, // 19: example.Schedule.every:type_name -> google.protobuf.Duration
+	2,  // 20: example.Schedule.owner:type_name -> example.BasicMessage
+	34, // 21: example.Schedule.event_times:type_name -> google.protobuf.Timestamp
+	30, // 22: example.Schedule.timeouts:type_name -> example.Schedule.TimeoutsEntry
+	2,  // 23: example.ProtojsonExample.basic:type_name -> example.BasicMessage
+	0,  // 24: example.ProtojsonExample.level:type_name -> example.Priority
+	2,  // 25: example.PersistenceExample.blob:type_name -> example.BasicMessage
+	2,  // 26: example.PersistenceExample.config:type_name -> example.BasicMessage
+	2,  // 27: example.PersistenceExample.token:type_name -> example.BasicMessage
+	2,  // 28: example.SqlExample.document:type_name -> example.BasicMessage
+	2,  // 29: example.SqlExample.snapshot:type_name -> example.BasicMessage
+	0,  // 30: example.Validated.severity:type_name -> example.Priority
+	34, // 31: example.Validated.starts_at:type_name -> google.protobuf.Timestamp
+	31, // 32: example.Validated.window:type_name -> example.Validated.Window
+	32, // 33: example.Account.credentials:type_name -> example.Account.Credentials
+	32, // 34: example.Account.previous_credentials:type_name -> example.Account.Credentials
+	0,  // 35: example.RetryPolicy.escalation:type_name -> example.Priority
+	33, // 36: example.Customer.id:type_name -> identifier.Identifier
+	29, // 37: example.Envelope.Header.labels:type_name -> example.Envelope.Header.LabelsEntry
+	39, // 38: example.Schedule.TimeoutsEntry.value:type_name -> google.protobuf.Duration
+	39, // 39: example.Validated.Window.length:type_name -> google.protobuf.Duration
+	40, // [40:40] is the sub-list for method output_type
+	40, // [40:40] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
+}
+
+func init() { file_example_proto_init() }
+func file_example_proto_init() {
+	if File_example_proto != nil {
+		return
+	}
+	file_example_proto_msgTypes[3].OneofWrappers = []any{
+		(*OneofMessage_Text)(nil),
+		(*OneofMessage_Number)(nil),
+	}
+	file_example_proto_msgTypes[15].OneofWrappers = []any{
+		(*SkipExample_Fast)(nil),
+		(*SkipExample_Slow)(nil),
+	}
+	file_example_proto_msgTypes[17].OneofWrappers = []any{
+		(*Schedule_At)(nil),
+		(*Schedule_Every)(nil),
+		(*Schedule_Owner)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_example_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   15,
+			NumEnums:      2,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -3,19 +3,26 @@
 package example
 
 import (
+	driver "database/sql/driver"
+	base64 "encoding/base64"
+	json "encoding/json"
+	fmt "fmt"
 	identifier "github.com/terwey/protoc-gen-go-options/example/identifier"
+	options "github.com/terwey/protoc-gen-go-options/options"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	prototext "google.golang.org/protobuf/encoding/prototext"
+	proto "google.golang.org/protobuf/proto"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
-)
-
-import (
-	"encoding/json"
-	"fmt"
-	"google.golang.org/protobuf/proto"
-	"time"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	time "time"
 )
 
 // BasicMessageOption defines a functional option for BasicMessage.
-type BasicMessageOption func(*BasicMessage)
+type BasicMessageOption = options.Option[*BasicMessage]
 
 // NewBasicMessage creates a new BasicMessage.
 func NewBasicMessage(opts ...BasicMessageOption) *BasicMessage {
@@ -34,6 +41,15 @@ func ApplyBasicMessageOptions(m *BasicMessage, opts ...BasicMessageOption) *Basi
 	return m
 }
 
+// CloneBasicMessageWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneBasicMessageWith(m *BasicMessage, opts ...BasicMessageOption) *BasicMessage {
+	c := proto.Clone(m).(*BasicMessage)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
 // WithName sets the Name field.
 func WithName(value string) BasicMessageOption {
 	return func(m *BasicMessage) {
@@ -41,6 +57,13 @@ func WithName(value string) BasicMessageOption {
 	}
 }
 
+// WithoutName clears the Name field.
+func WithoutName() BasicMessageOption {
+	return func(m *BasicMessage) {
+		m.Name = nil
+	}
+}
+
 // WithAge sets the Age field.
 func WithAge(value int32) BasicMessageOption {
 	return func(m *BasicMessage) {
@@ -48,6 +71,13 @@ func WithAge(value int32) BasicMessageOption {
 	}
 }
 
+// WithoutAge clears the Age field.
+func WithoutAge() BasicMessageOption {
+	return func(m *BasicMessage) {
+		m.Age = nil
+	}
+}
+
 // WithIsActive sets the IsActive field.
 func WithIsActive(value bool) BasicMessageOption {
 	return func(m *BasicMessage) {
@@ -55,8 +85,63 @@ func WithIsActive(value bool) BasicMessageOption {
 	}
 }
 
+// WithoutIsActive clears the IsActive field.
+func WithoutIsActive() BasicMessageOption {
+	return func(m *BasicMessage) {
+		m.IsActive = nil
+	}
+}
+
+// BasicMessageBuilder builds a BasicMessage through chained methods mirroring its options.
+// P is the type Done returns, the parent builder of a nested builder and struct{} for builders created by NewBasicMessageBuilder.
+type BasicMessageBuilder[P any] struct {
+	m      *BasicMessage
+	parent P
+}
+
+// NewBasicMessageBuilder returns a builder for a new BasicMessage.
+func NewBasicMessageBuilder() *BasicMessageBuilder[struct{}] {
+	return &BasicMessageBuilder[struct{}]{m: &BasicMessage{}}
+}
+
+// Apply applies the options to the message being built.
+func (b *BasicMessageBuilder[P]) Apply(opts ...BasicMessageOption) *BasicMessageBuilder[P] {
+	for _, opt := range opts {
+		opt(b.m)
+	}
+	return b
+}
+
+// Build returns a copy of the BasicMessage built so far, the builder can be used further.
+func (b *BasicMessageBuilder[P]) Build() *BasicMessage {
+	return proto.Clone(b.m).(*BasicMessage)
+}
+
+// Done returns the builder this builder was created from.
+func (b *BasicMessageBuilder[P]) Done() P {
+	return b.parent
+}
+
+// Name sets the Name field, see WithName.
+func (b *BasicMessageBuilder[P]) Name(value string) *BasicMessageBuilder[P] {
+	WithName(value)(b.m)
+	return b
+}
+
+// Age sets the Age field, see WithAge.
+func (b *BasicMessageBuilder[P]) Age(value int32) *BasicMessageBuilder[P] {
+	WithAge(value)(b.m)
+	return b
+}
+
+// IsActive sets the IsActive field, see WithIsActive.
+func (b *BasicMessageBuilder[P]) IsActive(value bool) *BasicMessageBuilder[P] {
+	WithIsActive(value)(b.m)
+	return b
+}
+
 // RepeatedFieldsMessageOption defines a functional option for RepeatedFieldsMessage.
-type RepeatedFieldsMessageOption func(*RepeatedFieldsMessage)
+type RepeatedFieldsMessageOption = options.Option[*RepeatedFieldsMessage]
 
 // NewRepeatedFieldsMessage creates a new RepeatedFieldsMessage.
 func NewRepeatedFieldsMessage(opts ...RepeatedFieldsMessageOption) *RepeatedFieldsMessage {
@@ -75,6 +160,15 @@ func ApplyRepeatedFieldsMessageOptions(m *RepeatedFieldsMessage, opts ...Repeate
 	return m
 }
 
+// CloneRepeatedFieldsMessageWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneRepeatedFieldsMessageWith(m *RepeatedFieldsMessage, opts ...RepeatedFieldsMessageOption) *RepeatedFieldsMessage {
+	c := proto.Clone(m).(*RepeatedFieldsMessage)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
 // WithTags sets the Tags field.
 func WithTags(values ...string) RepeatedFieldsMessageOption {
 	return func(m *RepeatedFieldsMessage) {
@@ -82,6 +176,20 @@ func WithTags(values ...string) RepeatedFieldsMessageOption {
 	}
 }
 
+// AddTags appends the values to the Tags field.
+func AddTags(values ...string) RepeatedFieldsMessageOption {
+	return func(m *RepeatedFieldsMessage) {
+		m.Tags = append(m.Tags, values...)
+	}
+}
+
+// WithoutTags clears the Tags field.
+func WithoutTags() RepeatedFieldsMessageOption {
+	return func(m *RepeatedFieldsMessage) {
+		m.Tags = nil
+	}
+}
+
 // WithValues sets the Values field.
 func WithValues(values ...int32) RepeatedFieldsMessageOption {
 	return func(m *RepeatedFieldsMessage) {
@@ -89,8 +197,22 @@ func WithValues(values ...int32) RepeatedFieldsMessageOption {
 	}
 }
 
+// AddValues appends the values to the Values field.
+func AddValues(values ...int32) RepeatedFieldsMessageOption {
+	return func(m *RepeatedFieldsMessage) {
+		m.Values = append(m.Values, values...)
+	}
+}
+
+// WithoutValues clears the Values field.
+func WithoutValues() RepeatedFieldsMessageOption {
+	return func(m *RepeatedFieldsMessage) {
+		m.Values = nil
+	}
+}
+
 // NestedMessageOption defines a functional option for NestedMessage.
-type NestedMessageOption func(*NestedMessage)
+type NestedMessageOption = options.Option[*NestedMessage]
 
 // NewNestedMessage creates a new NestedMessage.
 func NewNestedMessage(opts ...NestedMessageOption) *NestedMessage {
@@ -109,6 +231,15 @@ func ApplyNestedMessageOptions(m *NestedMessage, opts ...NestedMessageOption) *N
 	return m
 }
 
+// CloneNestedMessageWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneNestedMessageWith(m *NestedMessage, opts ...NestedMessageOption) *NestedMessage {
+	c := proto.Clone(m).(*NestedMessage)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
 // WithNewBasicForNestedMessage sets the Basic field with a new instance.
 func WithNewBasicForNestedMessage(opts ...BasicMessageOption) NestedMessageOption {
 	return func(m *NestedMessage) {
@@ -123,6 +254,13 @@ func WithBasicForNestedMessage(value *BasicMessage) NestedMessageOption {
 	}
 }
 
+// WithoutBasicForNestedMessage clears the Basic field.
+func WithoutBasicForNestedMessage() NestedMessageOption {
+	return func(m *NestedMessage) {
+		m.Basic = nil
+	}
+}
+
 // WithDescription sets the Description field.
 func WithDescription(value string) NestedMessageOption {
 	return func(m *NestedMessage) {
@@ -130,8 +268,60 @@ func WithDescription(value string) NestedMessageOption {
 	}
 }
 
+// WithoutDescription clears the Description field.
+func WithoutDescription() NestedMessageOption {
+	return func(m *NestedMessage) {
+		m.Description = nil
+	}
+}
+
+// NestedMessageBuilder builds a NestedMessage through chained methods mirroring its options.
+// P is the type Done returns, the parent builder of a nested builder and struct{} for builders created by NewNestedMessageBuilder.
+type NestedMessageBuilder[P any] struct {
+	m      *NestedMessage
+	parent P
+}
+
+// NewNestedMessageBuilder returns a builder for a new NestedMessage.
+func NewNestedMessageBuilder() *NestedMessageBuilder[struct{}] {
+	return &NestedMessageBuilder[struct{}]{m: &NestedMessage{}}
+}
+
+// Apply applies the options to the message being built.
+func (b *NestedMessageBuilder[P]) Apply(opts ...NestedMessageOption) *NestedMessageBuilder[P] {
+	for _, opt := range opts {
+		opt(b.m)
+	}
+	return b
+}
+
+// Build returns a copy of the NestedMessage built so far, the builder can be used further.
+func (b *NestedMessageBuilder[P]) Build() *NestedMessage {
+	return proto.Clone(b.m).(*NestedMessage)
+}
+
+// Done returns the builder this builder was created from.
+func (b *NestedMessageBuilder[P]) Done() P {
+	return b.parent
+}
+
+// Basic returns a builder for the Basic field, Done returns to this builder.
+// The field is set to a new message first when it is unset.
+func (b *NestedMessageBuilder[P]) Basic() *BasicMessageBuilder[*NestedMessageBuilder[P]] {
+	if b.m.GetBasic() == nil {
+		WithBasicForNestedMessage(&BasicMessage{})(b.m)
+	}
+	return &BasicMessageBuilder[*NestedMessageBuilder[P]]{m: b.m.GetBasic(), parent: b}
+}
+
+// Description sets the Description field, see WithDescription.
+func (b *NestedMessageBuilder[P]) Description(value string) *NestedMessageBuilder[P] {
+	WithDescription(value)(b.m)
+	return b
+}
+
 // OneofMessageOption defines a functional option for OneofMessage.
-type OneofMessageOption func(*OneofMessage)
+type OneofMessageOption = options.Option[*OneofMessage]
 
 // NewOneofMessage creates a new OneofMessage.
 func NewOneofMessage(opts ...OneofMessageOption) *OneofMessage {
@@ -150,6 +340,15 @@ func ApplyOneofMessageOptions(m *OneofMessage, opts ...OneofMessageOption) *Oneo
 	return m
 }
 
+// CloneOneofMessageWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneOneofMessageWith(m *OneofMessage, opts ...OneofMessageOption) *OneofMessage {
+	c := proto.Clone(m).(*OneofMessage)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
 // WithText sets the Choice oneof field to Text.
 func WithText(value string) OneofMessageOption {
 	return func(m *OneofMessage) {
@@ -168,8 +367,15 @@ func WithNumber(value int32) OneofMessageOption {
 	}
 }
 
+// WithoutChoice clears the Choice oneof field.
+func WithoutChoice() OneofMessageOption {
+	return func(m *OneofMessage) {
+		m.Choice = nil
+	}
+}
+
 // ComplexMessageOption defines a functional option for ComplexMessage.
-type ComplexMessageOption func(*ComplexMessage)
+type ComplexMessageOption = options.Option[*ComplexMessage]
 
 // NewComplexMessage creates a new ComplexMessage.
 func NewComplexMessage(opts ...ComplexMessageOption) *ComplexMessage {
@@ -188,6 +394,15 @@ func ApplyComplexMessageOptions(m *ComplexMessage, opts ...ComplexMessageOption)
 	return m
 }
 
+// CloneComplexMessageWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneComplexMessageWith(m *ComplexMessage, opts ...ComplexMessageOption) *ComplexMessage {
+	c := proto.Clone(m).(*ComplexMessage)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
 // WithNewNestedForComplexMessage sets the Nested field with a new instance.
 func WithNewNestedForComplexMessage(opts ...NestedMessageOption) ComplexMessageOption {
 	return func(m *ComplexMessage) {
@@ -202,6 +417,13 @@ func WithNested(value *NestedMessage) ComplexMessageOption {
 	}
 }
 
+// WithoutNested clears the Nested field.
+func WithoutNested() ComplexMessageOption {
+	return func(m *ComplexMessage) {
+		m.Nested = nil
+	}
+}
+
 // WithNestedList sets the NestedList field.
 func WithNestedList(values ...*NestedMessage) ComplexMessageOption {
 	return func(m *ComplexMessage) {
@@ -209,6 +431,20 @@ func WithNestedList(values ...*NestedMessage) ComplexMessageOption {
 	}
 }
 
+// AddNestedList appends a new element built from the options to the NestedList field.
+func AddNestedList(opts ...NestedMessageOption) ComplexMessageOption {
+	return func(m *ComplexMessage) {
+		m.NestedList = append(m.NestedList, NewNestedMessage(opts...))
+	}
+}
+
+// WithoutNestedList clears the NestedList field.
+func WithoutNestedList() ComplexMessageOption {
+	return func(m *ComplexMessage) {
+		m.NestedList = nil
+	}
+}
+
 // WithMetadata sets the Metadata field.
 func WithMetadata(value map[string]int32) ComplexMessageOption {
 	return func(m *ComplexMessage) {
@@ -216,8 +452,88 @@ func WithMetadata(value map[string]int32) ComplexMessageOption {
 	}
 }
 
+// PutMetadata sets the entry for key in the Metadata field.
+func PutMetadata(key string, value int32) ComplexMessageOption {
+	return func(m *ComplexMessage) {
+		if m.Metadata == nil {
+			m.Metadata = make(map[string]int32)
+		}
+		m.Metadata[key] = value
+	}
+}
+
+// MergeMetadata copies the entries of value into the Metadata field, overwriting existing keys.
+func MergeMetadata(value map[string]int32) ComplexMessageOption {
+	return func(m *ComplexMessage) {
+		if m.Metadata == nil {
+			m.Metadata = make(map[string]int32, len(value))
+		}
+		for k, v := range value {
+			m.Metadata[k] = v
+		}
+	}
+}
+
+// WithoutMetadata clears the Metadata field.
+func WithoutMetadata() ComplexMessageOption {
+	return func(m *ComplexMessage) {
+		m.Metadata = nil
+	}
+}
+
+// ComplexMessageBuilder builds a ComplexMessage through chained methods mirroring its options.
+// P is the type Done returns, the parent builder of a nested builder and struct{} for builders created by NewComplexMessageBuilder.
+type ComplexMessageBuilder[P any] struct {
+	m      *ComplexMessage
+	parent P
+}
+
+// NewComplexMessageBuilder returns a builder for a new ComplexMessage.
+func NewComplexMessageBuilder() *ComplexMessageBuilder[struct{}] {
+	return &ComplexMessageBuilder[struct{}]{m: &ComplexMessage{}}
+}
+
+// Apply applies the options to the message being built.
+func (b *ComplexMessageBuilder[P]) Apply(opts ...ComplexMessageOption) *ComplexMessageBuilder[P] {
+	for _, opt := range opts {
+		opt(b.m)
+	}
+	return b
+}
+
+// Build returns a copy of the ComplexMessage built so far, the builder can be used further.
+func (b *ComplexMessageBuilder[P]) Build() *ComplexMessage {
+	return proto.Clone(b.m).(*ComplexMessage)
+}
+
+// Done returns the builder this builder was created from.
+func (b *ComplexMessageBuilder[P]) Done() P {
+	return b.parent
+}
+
+// Nested returns a builder for the Nested field, Done returns to this builder.
+// The field is set to a new message first when it is unset.
+func (b *ComplexMessageBuilder[P]) Nested() *NestedMessageBuilder[*ComplexMessageBuilder[P]] {
+	if b.m.GetNested() == nil {
+		WithNested(&NestedMessage{})(b.m)
+	}
+	return &NestedMessageBuilder[*ComplexMessageBuilder[P]]{m: b.m.GetNested(), parent: b}
+}
+
+// NestedList sets the NestedList field, see WithNestedList.
+func (b *ComplexMessageBuilder[P]) NestedList(values ...*NestedMessage) *ComplexMessageBuilder[P] {
+	WithNestedList(values...)(b.m)
+	return b
+}
+
+// Metadata sets the Metadata field, see WithMetadata.
+func (b *ComplexMessageBuilder[P]) Metadata(value map[string]int32) *ComplexMessageBuilder[P] {
+	WithMetadata(value)(b.m)
+	return b
+}
+
 // FooOption defines a functional option for Foo.
-type FooOption func(*Foo)
+type FooOption = options.Option[*Foo]
 
 // NewFoo creates a new Foo.
 func NewFoo(opts ...FooOption) *Foo {
@@ -236,6 +552,15 @@ func ApplyFooOptions(m *Foo, opts ...FooOption) *Foo {
 	return m
 }
 
+// CloneFooWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneFooWith(m *Foo, opts ...FooOption) *Foo {
+	c := proto.Clone(m).(*Foo)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
 // WithNewIdForFoo sets the Id field with a new instance.
 func WithNewIdForFoo() FooOption {
 	return func(m *Foo) {
@@ -250,8 +575,15 @@ func WithIdForFoo(value *identifier.Identifier) FooOption {
 	}
 }
 
+// WithoutIdForFoo clears the Id field.
+func WithoutIdForFoo() FooOption {
+	return func(m *Foo) {
+		m.Id = nil
+	}
+}
+
 // BarOption defines a functional option for Bar.
-type BarOption func(*Bar)
+type BarOption = options.Option[*Bar]
 
 // NewBar creates a new Bar.
 func NewBar(opts ...BarOption) *Bar {
@@ -270,6 +602,15 @@ func ApplyBarOptions(m *Bar, opts ...BarOption) *Bar {
 	return m
 }
 
+// CloneBarWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneBarWith(m *Bar, opts ...BarOption) *Bar {
+	c := proto.Clone(m).(*Bar)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
 // WithNewIdForBar sets the Id field with a new instance.
 func WithNewIdForBar() BarOption {
 	return func(m *Bar) {
@@ -284,8 +625,15 @@ func WithIdForBar(value *identifier.Identifier) BarOption {
 	}
 }
 
+// WithoutIdForBar clears the Id field.
+func WithoutIdForBar() BarOption {
+	return func(m *Bar) {
+		m.Id = nil
+	}
+}
+
 // SomeMessageOption defines a functional option for SomeMessage.
-type SomeMessageOption func(*SomeMessage)
+type SomeMessageOption = options.Option[*SomeMessage]
 
 // NewSomeMessage creates a new SomeMessage.
 func NewSomeMessage(opts ...SomeMessageOption) *SomeMessage {
@@ -304,6 +652,15 @@ func ApplySomeMessageOptions(m *SomeMessage, opts ...SomeMessageOption) *SomeMes
 	return m
 }
 
+// CloneSomeMessageWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneSomeMessageWith(m *SomeMessage, opts ...SomeMessageOption) *SomeMessage {
+	c := proto.Clone(m).(*SomeMessage)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
 // WithNewIdentifierForSomeMessage sets the Identifier field with a new instance.
 func WithNewIdentifierForSomeMessage() SomeMessageOption {
 	return func(m *SomeMessage) {
@@ -318,6 +675,13 @@ func WithIdentifier(value *identifier.Identifier) SomeMessageOption {
 	}
 }
 
+// WithoutIdentifier clears the Identifier field.
+func WithoutIdentifier() SomeMessageOption {
+	return func(m *SomeMessage) {
+		m.Identifier = nil
+	}
+}
+
 // WithInclude sets the Include field.
 func WithInclude(values ...*identifier.Identifier) SomeMessageOption {
 	return func(m *SomeMessage) {
@@ -325,8 +689,22 @@ func WithInclude(values ...*identifier.Identifier) SomeMessageOption {
 	}
 }
 
+// AddInclude appends a new element built from the options to the Include field.
+func AddInclude(opts ...identifier.IdentifierOption) SomeMessageOption {
+	return func(m *SomeMessage) {
+		m.Include = append(m.Include, identifier.NewIdentifier(opts...))
+	}
+}
+
+// WithoutInclude clears the Include field.
+func WithoutInclude() SomeMessageOption {
+	return func(m *SomeMessage) {
+		m.Include = nil
+	}
+}
+
 // NoInitOption defines a functional option for NoInit.
-type NoInitOption func(*NoInit)
+type NoInitOption = options.Option[*NoInit]
 
 // ApplyNoInitOptions applies the provided options to an existing NoInit.
 func ApplyNoInitOptions(m *NoInit, opts ...NoInitOption) *NoInit {
@@ -336,6 +714,15 @@ func ApplyNoInitOptions(m *NoInit, opts ...NoInitOption) *NoInit {
 	return m
 }
 
+// CloneNoInitWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneNoInitWith(m *NoInit, opts ...NoInitOption) *NoInit {
+	c := proto.Clone(m).(*NoInit)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
 // WithNoInitName sets the NoInitName field.
 func WithNoInitName(value string) NoInitOption {
 	return func(m *NoInit) {
@@ -343,8 +730,15 @@ func WithNoInitName(value string) NoInitOption {
 	}
 }
 
+// WithoutNoInitName clears the NoInitName field.
+func WithoutNoInitName() NoInitOption {
+	return func(m *NoInit) {
+		m.NoInitName = nil
+	}
+}
+
 // FooBarWithEnumOption defines a functional option for FooBarWithEnum.
-type FooBarWithEnumOption func(*FooBarWithEnum)
+type FooBarWithEnumOption = options.Option[*FooBarWithEnum]
 
 // NewFooBarWithEnum creates a new FooBarWithEnum.
 func NewFooBarWithEnum(opts ...FooBarWithEnumOption) *FooBarWithEnum {
@@ -363,15 +757,45 @@ func ApplyFooBarWithEnumOptions(m *FooBarWithEnum, opts ...FooBarWithEnumOption)
 	return m
 }
 
+// CloneFooBarWithEnumWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneFooBarWithEnumWith(m *FooBarWithEnum, opts ...FooBarWithEnumOption) *FooBarWithEnum {
+	c := proto.Clone(m).(*FooBarWithEnum)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
 // WithStatus sets the Status field.
-func WithStatus(value *FooBarWithEnum_Status) FooBarWithEnumOption {
+func WithStatus(value FooBarWithEnum_Status) FooBarWithEnumOption {
+	return func(m *FooBarWithEnum) {
+		m.Status = value.Enum()
+	}
+}
+
+// WithStatusActive sets the Status field to ACTIVE.
+func WithStatusActive() FooBarWithEnumOption {
 	return func(m *FooBarWithEnum) {
-		m.Status = value
+		m.Status = FooBarWithEnum_ACTIVE.Enum()
+	}
+}
+
+// WithStatusInactive sets the Status field to INACTIVE.
+func WithStatusInactive() FooBarWithEnumOption {
+	return func(m *FooBarWithEnum) {
+		m.Status = FooBarWithEnum_INACTIVE.Enum()
+	}
+}
+
+// WithoutStatus clears the Status field.
+func WithoutStatus() FooBarWithEnumOption {
+	return func(m *FooBarWithEnum) {
+		m.Status = nil
 	}
 }
 
 // JsonExampleOption defines a functional option for JsonExample.
-type JsonExampleOption func(*JsonExample)
+type JsonExampleOption = options.Option[*JsonExample]
 
 // NewJsonExample creates a new JsonExample.
 func NewJsonExample(opts ...JsonExampleOption) *JsonExample {
@@ -390,6 +814,15 @@ func ApplyJsonExampleOptions(m *JsonExample, opts ...JsonExampleOption) *JsonExa
 	return m
 }
 
+// CloneJsonExampleWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneJsonExampleWith(m *JsonExample, opts ...JsonExampleOption) *JsonExample {
+	c := proto.Clone(m).(*JsonExample)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
 // GetBasicAsJSON returns the Basic field as a JSON byte slice.
 func (m *JsonExample) GetBasicAsJSON() ([]byte, error) {
 	out, err := json.Marshal(m.Basic)
@@ -418,8 +851,15 @@ func WithBasicForJsonExample(value *BasicMessage) JsonExampleOption {
 	}
 }
 
+// WithoutBasicForJsonExample clears the Basic field.
+func WithoutBasicForJsonExample() JsonExampleOption {
+	return func(m *JsonExample) {
+		m.Basic = nil
+	}
+}
+
 // PrimitivesOption defines a functional option for Primitives.
-type PrimitivesOption func(*Primitives)
+type PrimitivesOption = options.Option[*Primitives]
 
 // NewPrimitives creates a new Primitives.
 func NewPrimitives(opts ...PrimitivesOption) *Primitives {
@@ -438,6 +878,15 @@ func ApplyPrimitivesOptions(m *Primitives, opts ...PrimitivesOption) *Primitives
 	return m
 }
 
+// ClonePrimitivesWith returns a copy of m with the provided options applied, m itself is not modified.
+func ClonePrimitivesWith(m *Primitives, opts ...PrimitivesOption) *Primitives {
+	c := proto.Clone(m).(*Primitives)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
 // WithInteger64 sets the Integer64 field.
 func WithInteger64(value int64) PrimitivesOption {
 	return func(m *Primitives) {
@@ -445,8 +894,15 @@ func WithInteger64(value int64) PrimitivesOption {
 	}
 }
 
+// WithoutInteger64 clears the Integer64 field.
+func WithoutInteger64() PrimitivesOption {
+	return func(m *Primitives) {
+		m.Integer64 = nil
+	}
+}
+
 // WellKnownOption defines a functional option for WellKnown.
-type WellKnownOption func(*WellKnown)
+type WellKnownOption = options.Option[*WellKnown]
 
 // NewWellKnown creates a new WellKnown.
 func NewWellKnown(opts ...WellKnownOption) *WellKnown {
@@ -465,6 +921,15 @@ func ApplyWellKnownOptions(m *WellKnown, opts ...WellKnownOption) *WellKnown {
 	return m
 }
 
+// CloneWellKnownWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneWellKnownWith(m *WellKnown, opts ...WellKnownOption) *WellKnown {
+	c := proto.Clone(m).(*WellKnown)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
 // WithNewCreatedAtForWellKnown sets the CreatedAt field with a new instance.
 func WithNewCreatedAtForWellKnown(v time.Time) WellKnownOption {
 	return func(m *WellKnown) {
@@ -478,3 +943,1780 @@ func WithCreatedAt(value *timestamppb.Timestamp) WellKnownOption {
 		m.CreatedAt = value
 	}
 }
+
+// WithoutCreatedAt clears the CreatedAt field.
+func WithoutCreatedAt() WellKnownOption {
+	return func(m *WellKnown) {
+		m.CreatedAt = nil
+	}
+}
+
+// WithNewMaxRetriesForWellKnown sets the MaxRetries field to v wrapped in an Int32Value.
+func WithNewMaxRetriesForWellKnown(v int32) WellKnownOption {
+	return func(m *WellKnown) {
+		m.MaxRetries = wrapperspb.Int32(v)
+	}
+}
+
+// WithMaxRetries sets the MaxRetries field directly.
+func WithMaxRetries(value *wrapperspb.Int32Value) WellKnownOption {
+	return func(m *WellKnown) {
+		m.MaxRetries = value
+	}
+}
+
+// WithoutMaxRetries clears the MaxRetries field.
+func WithoutMaxRetries() WellKnownOption {
+	return func(m *WellKnown) {
+		m.MaxRetries = nil
+	}
+}
+
+// WithNewAttributesForWellKnown sets the Attributes field to a Struct converted from v, values that cannot be converted are rejected.
+func WithNewAttributesForWellKnown(v map[string]any) (WellKnownOption, error) {
+	value, err := structpb.NewStruct(v)
+	if err != nil {
+		return nil, err
+	}
+	return func(m *WellKnown) {
+		m.Attributes = value
+	}, nil
+}
+
+// WithAttributes sets the Attributes field directly.
+func WithAttributes(value *structpb.Struct) WellKnownOption {
+	return func(m *WellKnown) {
+		m.Attributes = value
+	}
+}
+
+// WithoutAttributes clears the Attributes field.
+func WithoutAttributes() WellKnownOption {
+	return func(m *WellKnown) {
+		m.Attributes = nil
+	}
+}
+
+// WithNewAttachmentForWellKnown sets the Attachment field to v packed into an Any, messages that cannot be marshaled are rejected.
+func WithNewAttachmentForWellKnown(v proto.Message) (WellKnownOption, error) {
+	value, err := anypb.New(v)
+	if err != nil {
+		return nil, err
+	}
+	return func(m *WellKnown) {
+		m.Attachment = value
+	}, nil
+}
+
+// WithAttachment sets the Attachment field directly.
+func WithAttachment(value *anypb.Any) WellKnownOption {
+	return func(m *WellKnown) {
+		m.Attachment = value
+	}
+}
+
+// WithoutAttachment clears the Attachment field.
+func WithoutAttachment() WellKnownOption {
+	return func(m *WellKnown) {
+		m.Attachment = nil
+	}
+}
+
+// WithNewPingForWellKnown sets the Ping field to an empty message.
+func WithNewPingForWellKnown() WellKnownOption {
+	return func(m *WellKnown) {
+		m.Ping = &emptypb.Empty{}
+	}
+}
+
+// WithPing sets the Ping field directly.
+func WithPing(value *emptypb.Empty) WellKnownOption {
+	return func(m *WellKnown) {
+		m.Ping = value
+	}
+}
+
+// WithoutPing clears the Ping field.
+func WithoutPing() WellKnownOption {
+	return func(m *WellKnown) {
+		m.Ping = nil
+	}
+}
+
+// EnvelopeOption defines a functional option for Envelope.
+type EnvelopeOption = options.Option[*Envelope]
+
+// NewEnvelope creates a new Envelope.
+func NewEnvelope(opts ...EnvelopeOption) *Envelope {
+	m := &Envelope{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// ApplyEnvelopeOptions applies the provided options to an existing Envelope.
+func ApplyEnvelopeOptions(m *Envelope, opts ...EnvelopeOption) *Envelope {
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CloneEnvelopeWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneEnvelopeWith(m *Envelope, opts ...EnvelopeOption) *Envelope {
+	c := proto.Clone(m).(*Envelope)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithNewHeaderForEnvelope sets the Header field with a new instance.
+func WithNewHeaderForEnvelope(opts ...Envelope_HeaderOption) EnvelopeOption {
+	return func(m *Envelope) {
+		m.Header = NewEnvelope_Header(opts...)
+	}
+}
+
+// WithHeader sets the Header field directly.
+func WithHeader(value *Envelope_Header) EnvelopeOption {
+	return func(m *Envelope) {
+		m.Header = value
+	}
+}
+
+// WithoutHeader clears the Header field.
+func WithoutHeader() EnvelopeOption {
+	return func(m *Envelope) {
+		m.Header = nil
+	}
+}
+
+// WithForwarded sets the Forwarded field.
+func WithForwarded(values ...*Envelope_Header) EnvelopeOption {
+	return func(m *Envelope) {
+		m.Forwarded = values
+	}
+}
+
+// AddForwarded appends a new element built from the options to the Forwarded field.
+func AddForwarded(opts ...Envelope_HeaderOption) EnvelopeOption {
+	return func(m *Envelope) {
+		m.Forwarded = append(m.Forwarded, NewEnvelope_Header(opts...))
+	}
+}
+
+// WithoutForwarded clears the Forwarded field.
+func WithoutForwarded() EnvelopeOption {
+	return func(m *Envelope) {
+		m.Forwarded = nil
+	}
+}
+
+// Envelope_HeaderOption defines a functional option for Envelope_Header.
+type Envelope_HeaderOption = options.Option[*Envelope_Header]
+
+// NewEnvelope_Header creates a new Envelope_Header.
+func NewEnvelope_Header(opts ...Envelope_HeaderOption) *Envelope_Header {
+	m := &Envelope_Header{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// ApplyEnvelope_HeaderOptions applies the provided options to an existing Envelope_Header.
+func ApplyEnvelope_HeaderOptions(m *Envelope_Header, opts ...Envelope_HeaderOption) *Envelope_Header {
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CloneEnvelope_HeaderWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneEnvelope_HeaderWith(m *Envelope_Header, opts ...Envelope_HeaderOption) *Envelope_Header {
+	c := proto.Clone(m).(*Envelope_Header)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithTraceId sets the TraceId field.
+func WithTraceId(value string) Envelope_HeaderOption {
+	return func(m *Envelope_Header) {
+		m.TraceId = proto.String(value)
+	}
+}
+
+// WithoutTraceId clears the TraceId field.
+func WithoutTraceId() Envelope_HeaderOption {
+	return func(m *Envelope_Header) {
+		m.TraceId = nil
+	}
+}
+
+// WithLabels sets the Labels field.
+func WithLabels(value map[string]string) Envelope_HeaderOption {
+	return func(m *Envelope_Header) {
+		m.Labels = value
+	}
+}
+
+// PutLabels sets the entry for key in the Labels field.
+func PutLabels(key string, value string) Envelope_HeaderOption {
+	return func(m *Envelope_Header) {
+		if m.Labels == nil {
+			m.Labels = make(map[string]string)
+		}
+		m.Labels[key] = value
+	}
+}
+
+// MergeLabels copies the entries of value into the Labels field, overwriting existing keys.
+func MergeLabels(value map[string]string) Envelope_HeaderOption {
+	return func(m *Envelope_Header) {
+		if m.Labels == nil {
+			m.Labels = make(map[string]string, len(value))
+		}
+		for k, v := range value {
+			m.Labels[k] = v
+		}
+	}
+}
+
+// WithoutLabels clears the Labels field.
+func WithoutLabels() Envelope_HeaderOption {
+	return func(m *Envelope_Header) {
+		m.Labels = nil
+	}
+}
+
+// SkipExampleOption defines a functional option for SkipExample.
+type SkipExampleOption = options.Option[*SkipExample]
+
+// NewSkipExample creates a new SkipExample.
+func NewSkipExample(opts ...SkipExampleOption) *SkipExample {
+	m := &SkipExample{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// ApplySkipExampleOptions applies the provided options to an existing SkipExample.
+func ApplySkipExampleOptions(m *SkipExample, opts ...SkipExampleOption) *SkipExample {
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CloneSkipExampleWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneSkipExampleWith(m *SkipExample, opts ...SkipExampleOption) *SkipExample {
+	c := proto.Clone(m).(*SkipExample)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithKept sets the Kept field.
+func WithKept(value string) SkipExampleOption {
+	return func(m *SkipExample) {
+		m.Kept = proto.String(value)
+	}
+}
+
+// WithoutKept clears the Kept field.
+func WithoutKept() SkipExampleOption {
+	return func(m *SkipExample) {
+		m.Kept = nil
+	}
+}
+
+// ImplicitPresenceOption defines a functional option for ImplicitPresence.
+type ImplicitPresenceOption = options.Option[*ImplicitPresence]
+
+// NewImplicitPresence creates a new ImplicitPresence.
+func NewImplicitPresence(opts ...ImplicitPresenceOption) *ImplicitPresence {
+	m := &ImplicitPresence{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// ApplyImplicitPresenceOptions applies the provided options to an existing ImplicitPresence.
+func ApplyImplicitPresenceOptions(m *ImplicitPresence, opts ...ImplicitPresenceOption) *ImplicitPresence {
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CloneImplicitPresenceWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneImplicitPresenceWith(m *ImplicitPresence, opts ...ImplicitPresenceOption) *ImplicitPresence {
+	c := proto.Clone(m).(*ImplicitPresence)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithLabel sets the Label field.
+func WithLabel(value string) ImplicitPresenceOption {
+	return func(m *ImplicitPresence) {
+		m.Label = value
+	}
+}
+
+// WithoutLabel clears the Label field.
+func WithoutLabel() ImplicitPresenceOption {
+	return func(m *ImplicitPresence) {
+		m.Label = ""
+	}
+}
+
+// WithCount sets the Count field.
+func WithCount(value uint32) ImplicitPresenceOption {
+	return func(m *ImplicitPresence) {
+		m.Count = value
+	}
+}
+
+// WithoutCount clears the Count field.
+func WithoutCount() ImplicitPresenceOption {
+	return func(m *ImplicitPresence) {
+		m.Count = 0
+	}
+}
+
+// WithPayload sets the Payload field.
+func WithPayload(value []byte) ImplicitPresenceOption {
+	return func(m *ImplicitPresence) {
+		m.Payload = value
+	}
+}
+
+// WithoutPayload clears the Payload field.
+func WithoutPayload() ImplicitPresenceOption {
+	return func(m *ImplicitPresence) {
+		m.Payload = nil
+	}
+}
+
+// WithPriority sets the Priority field.
+func WithPriority(value Priority) ImplicitPresenceOption {
+	return func(m *ImplicitPresence) {
+		m.Priority = value
+	}
+}
+
+// WithPriorityLow sets the Priority field to PRIORITY_LOW.
+func WithPriorityLow() ImplicitPresenceOption {
+	return func(m *ImplicitPresence) {
+		m.Priority = Priority_PRIORITY_LOW
+	}
+}
+
+// WithPriorityHigh sets the Priority field to PRIORITY_HIGH.
+func WithPriorityHigh() ImplicitPresenceOption {
+	return func(m *ImplicitPresence) {
+		m.Priority = Priority_PRIORITY_HIGH
+	}
+}
+
+// WithoutPriority clears the Priority field.
+func WithoutPriority() ImplicitPresenceOption {
+	return func(m *ImplicitPresence) {
+		m.Priority = 0
+	}
+}
+
+// ScheduleOption defines a functional option for Schedule.
+type ScheduleOption = options.Option[*Schedule]
+
+// NewSchedule creates a new Schedule.
+func NewSchedule(opts ...ScheduleOption) *Schedule {
+	m := &Schedule{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// ApplyScheduleOptions applies the provided options to an existing Schedule.
+func ApplyScheduleOptions(m *Schedule, opts ...ScheduleOption) *Schedule {
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CloneScheduleWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneScheduleWith(m *Schedule, opts ...ScheduleOption) *Schedule {
+	c := proto.Clone(m).(*Schedule)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithEventTimes sets the EventTimes field.
+func WithEventTimes(values ...*timestamppb.Timestamp) ScheduleOption {
+	return func(m *Schedule) {
+		m.EventTimes = values
+	}
+}
+
+// AddEventTimes appends the values to the EventTimes field.
+func AddEventTimes(values ...*timestamppb.Timestamp) ScheduleOption {
+	return func(m *Schedule) {
+		m.EventTimes = append(m.EventTimes, values...)
+	}
+}
+
+// WithNewEventTimesForSchedule sets the EventTimes field with new instances converted from the values.
+func WithNewEventTimesForSchedule(values ...time.Time) ScheduleOption {
+	return func(m *Schedule) {
+		converted := make([]*timestamppb.Timestamp, len(values))
+		for i, v := range values {
+			converted[i] = timestamppb.New(v)
+		}
+		m.EventTimes = converted
+	}
+}
+
+// WithoutEventTimes clears the EventTimes field.
+func WithoutEventTimes() ScheduleOption {
+	return func(m *Schedule) {
+		m.EventTimes = nil
+	}
+}
+
+// WithTimeouts sets the Timeouts field.
+func WithTimeouts(value map[string]*durationpb.Duration) ScheduleOption {
+	return func(m *Schedule) {
+		m.Timeouts = value
+	}
+}
+
+// PutTimeouts sets the entry for key in the Timeouts field.
+func PutTimeouts(key string, value *durationpb.Duration) ScheduleOption {
+	return func(m *Schedule) {
+		if m.Timeouts == nil {
+			m.Timeouts = make(map[string]*durationpb.Duration)
+		}
+		m.Timeouts[key] = value
+	}
+}
+
+// MergeTimeouts copies the entries of value into the Timeouts field, overwriting existing keys.
+func MergeTimeouts(value map[string]*durationpb.Duration) ScheduleOption {
+	return func(m *Schedule) {
+		if m.Timeouts == nil {
+			m.Timeouts = make(map[string]*durationpb.Duration, len(value))
+		}
+		for k, v := range value {
+			m.Timeouts[k] = v
+		}
+	}
+}
+
+// WithNewTimeoutsForSchedule sets the Timeouts field with new instances converted from the values.
+func WithNewTimeoutsForSchedule(values map[string]time.Duration) ScheduleOption {
+	return func(m *Schedule) {
+		converted := make(map[string]*durationpb.Duration, len(values))
+		for k, v := range values {
+			converted[k] = durationpb.New(v)
+		}
+		m.Timeouts = converted
+	}
+}
+
+// WithoutTimeouts clears the Timeouts field.
+func WithoutTimeouts() ScheduleOption {
+	return func(m *Schedule) {
+		m.Timeouts = nil
+	}
+}
+
+// WithAt sets the When oneof field to At.
+func WithAt(value *timestamppb.Timestamp) ScheduleOption {
+	return func(m *Schedule) {
+		m.When = &Schedule_At{
+			At: value,
+		}
+	}
+}
+
+// WithNewAtForSchedule sets the At field with a new instance.
+func WithNewAtForSchedule(v time.Time) ScheduleOption {
+	return func(m *Schedule) {
+		m.When = &Schedule_At{
+			At: timestamppb.New(v),
+		}
+	}
+}
+
+// WithEvery sets the When oneof field to Every.
+func WithEvery(value *durationpb.Duration) ScheduleOption {
+	return func(m *Schedule) {
+		m.When = &Schedule_Every{
+			Every: value,
+		}
+	}
+}
+
+// WithNewEveryForSchedule sets the Every field with a new instance.
+func WithNewEveryForSchedule(v time.Duration) ScheduleOption {
+	return func(m *Schedule) {
+		m.When = &Schedule_Every{
+			Every: durationpb.New(v),
+		}
+	}
+}
+
+// WithOwner sets the When oneof field to Owner.
+func WithOwner(value *BasicMessage) ScheduleOption {
+	return func(m *Schedule) {
+		m.When = &Schedule_Owner{
+			Owner: value,
+		}
+	}
+}
+
+// WithNewOwnerForSchedule sets the Owner field with a new instance.
+func WithNewOwnerForSchedule(opts ...BasicMessageOption) ScheduleOption {
+	return func(m *Schedule) {
+		m.When = &Schedule_Owner{
+			Owner: NewBasicMessage(opts...),
+		}
+	}
+}
+
+// WithoutWhen clears the When oneof field.
+func WithoutWhen() ScheduleOption {
+	return func(m *Schedule) {
+		m.When = nil
+	}
+}
+
+// ProtojsonExampleOption defines a functional option for ProtojsonExample.
+type ProtojsonExampleOption = options.Option[*ProtojsonExample]
+
+// NewProtojsonExample creates a new ProtojsonExample.
+func NewProtojsonExample(opts ...ProtojsonExampleOption) *ProtojsonExample {
+	m := &ProtojsonExample{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// ApplyProtojsonExampleOptions applies the provided options to an existing ProtojsonExample.
+func ApplyProtojsonExampleOptions(m *ProtojsonExample, opts ...ProtojsonExampleOption) *ProtojsonExample {
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CloneProtojsonExampleWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneProtojsonExampleWith(m *ProtojsonExample, opts ...ProtojsonExampleOption) *ProtojsonExample {
+	c := proto.Clone(m).(*ProtojsonExample)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// GetBasicAsJSON returns the Basic field as a JSON byte slice encoded with protojson.
+func (m *ProtojsonExample) GetBasicAsJSON() ([]byte, error) {
+	out, err := protojson.MarshalOptions{}.Marshal(m.Basic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Basic field: %w", err)
+	}
+	return out, nil
+}
+
+// SetBasicFromJSON sets the Basic field from a JSON byte slice decoded with protojson.
+func (m *ProtojsonExample) SetBasicFromJSON(v []byte) error {
+	value := &BasicMessage{}
+	err := protojson.UnmarshalOptions{}.Unmarshal(v, value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal Basic field: %w", err)
+	}
+	m.Basic = value
+	return nil
+}
+
+// WithNewBasicForProtojsonExample sets the Basic field with a new instance.
+func WithNewBasicForProtojsonExample(opts ...BasicMessageOption) ProtojsonExampleOption {
+	return func(m *ProtojsonExample) {
+		m.Basic = NewBasicMessage(opts...)
+	}
+}
+
+// WithBasicForProtojsonExample sets the Basic field directly.
+func WithBasicForProtojsonExample(value *BasicMessage) ProtojsonExampleOption {
+	return func(m *ProtojsonExample) {
+		m.Basic = value
+	}
+}
+
+// WithoutBasicForProtojsonExample clears the Basic field.
+func WithoutBasicForProtojsonExample() ProtojsonExampleOption {
+	return func(m *ProtojsonExample) {
+		m.Basic = nil
+	}
+}
+
+// GetIdsAsJSON returns the Ids field as a JSON byte slice encoded with protojson.
+func (m *ProtojsonExample) GetIdsAsJSON() ([]byte, error) {
+	holder := &ProtojsonExample{}
+	holder.Ids = m.Ids
+	out, err := protojson.MarshalOptions{AllowPartial: true}.Marshal(holder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Ids field: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(out, &fields); err != nil {
+		return nil, fmt.Errorf("failed to marshal Ids field: %w", err)
+	}
+	if value, ok := fields["ids"]; ok {
+		return value, nil
+	}
+	return []byte("null"), nil
+}
+
+// SetIdsFromJSON sets the Ids field from a JSON byte slice decoded with protojson.
+func (m *ProtojsonExample) SetIdsFromJSON(v []byte) error {
+	holder := &ProtojsonExample{}
+	in := append(append([]byte("{\"ids\":"), v...), '}')
+	err := protojson.UnmarshalOptions{AllowPartial: true}.Unmarshal(in, holder)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal Ids field: %w", err)
+	}
+	m.Ids = holder.Ids
+	return nil
+}
+
+// WithIds sets the Ids field.
+func WithIds(values ...int64) ProtojsonExampleOption {
+	return func(m *ProtojsonExample) {
+		m.Ids = values
+	}
+}
+
+// AddIds appends the values to the Ids field.
+func AddIds(values ...int64) ProtojsonExampleOption {
+	return func(m *ProtojsonExample) {
+		m.Ids = append(m.Ids, values...)
+	}
+}
+
+// WithoutIds clears the Ids field.
+func WithoutIds() ProtojsonExampleOption {
+	return func(m *ProtojsonExample) {
+		m.Ids = nil
+	}
+}
+
+// GetLevelAsJSON returns the Level field as a JSON byte slice encoded with protojson.
+func (m *ProtojsonExample) GetLevelAsJSON() ([]byte, error) {
+	holder := &ProtojsonExample{}
+	holder.Level = m.Level
+	out, err := protojson.MarshalOptions{AllowPartial: true, UseProtoNames: true, EmitUnpopulated: true}.Marshal(holder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Level field: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(out, &fields); err != nil {
+		return nil, fmt.Errorf("failed to marshal Level field: %w", err)
+	}
+	if value, ok := fields["level"]; ok {
+		return value, nil
+	}
+	return []byte("null"), nil
+}
+
+// SetLevelFromJSON sets the Level field from a JSON byte slice decoded with protojson.
+func (m *ProtojsonExample) SetLevelFromJSON(v []byte) error {
+	holder := &ProtojsonExample{}
+	in := append(append([]byte("{\"level\":"), v...), '}')
+	err := protojson.UnmarshalOptions{AllowPartial: true, DiscardUnknown: true}.Unmarshal(in, holder)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal Level field: %w", err)
+	}
+	m.Level = holder.Level
+	return nil
+}
+
+// WithLevel sets the Level field.
+func WithLevel(value Priority) ProtojsonExampleOption {
+	return func(m *ProtojsonExample) {
+		m.Level = value.Enum()
+	}
+}
+
+// WithLevelLow sets the Level field to PRIORITY_LOW.
+func WithLevelLow() ProtojsonExampleOption {
+	return func(m *ProtojsonExample) {
+		m.Level = Priority_PRIORITY_LOW.Enum()
+	}
+}
+
+// WithLevelHigh sets the Level field to PRIORITY_HIGH.
+func WithLevelHigh() ProtojsonExampleOption {
+	return func(m *ProtojsonExample) {
+		m.Level = Priority_PRIORITY_HIGH.Enum()
+	}
+}
+
+// WithoutLevel clears the Level field.
+func WithoutLevel() ProtojsonExampleOption {
+	return func(m *ProtojsonExample) {
+		m.Level = nil
+	}
+}
+
+// PersistenceExampleOption defines a functional option for PersistenceExample.
+type PersistenceExampleOption = options.Option[*PersistenceExample]
+
+// NewPersistenceExample creates a new PersistenceExample.
+func NewPersistenceExample(opts ...PersistenceExampleOption) *PersistenceExample {
+	m := &PersistenceExample{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// ApplyPersistenceExampleOptions applies the provided options to an existing PersistenceExample.
+func ApplyPersistenceExampleOptions(m *PersistenceExample, opts ...PersistenceExampleOption) *PersistenceExample {
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// ClonePersistenceExampleWith returns a copy of m with the provided options applied, m itself is not modified.
+func ClonePersistenceExampleWith(m *PersistenceExample, opts ...PersistenceExampleOption) *PersistenceExample {
+	c := proto.Clone(m).(*PersistenceExample)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// GetBlobAsBinary returns the Blob field encoded in the protobuf binary format.
+func (m *PersistenceExample) GetBlobAsBinary() ([]byte, error) {
+	out, err := proto.Marshal(m.Blob)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Blob field: %w", err)
+	}
+	return out, nil
+}
+
+// SetBlobFromBinary sets the Blob field from the protobuf binary format.
+func (m *PersistenceExample) SetBlobFromBinary(v []byte) error {
+	value := &BasicMessage{}
+	if err := proto.Unmarshal(v, value); err != nil {
+		return fmt.Errorf("failed to unmarshal Blob field: %w", err)
+	}
+	m.Blob = value
+	return nil
+}
+
+// WithNewBlobForPersistenceExample sets the Blob field with a new instance.
+func WithNewBlobForPersistenceExample(opts ...BasicMessageOption) PersistenceExampleOption {
+	return func(m *PersistenceExample) {
+		m.Blob = NewBasicMessage(opts...)
+	}
+}
+
+// WithBlob sets the Blob field directly.
+func WithBlob(value *BasicMessage) PersistenceExampleOption {
+	return func(m *PersistenceExample) {
+		m.Blob = value
+	}
+}
+
+// WithoutBlob clears the Blob field.
+func WithoutBlob() PersistenceExampleOption {
+	return func(m *PersistenceExample) {
+		m.Blob = nil
+	}
+}
+
+// GetConfigAsText returns the Config field encoded in the protobuf text format.
+func (m *PersistenceExample) GetConfigAsText() ([]byte, error) {
+	out, err := prototext.Marshal(m.Config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Config field: %w", err)
+	}
+	return out, nil
+}
+
+// SetConfigFromText sets the Config field from the protobuf text format.
+func (m *PersistenceExample) SetConfigFromText(v []byte) error {
+	value := &BasicMessage{}
+	if err := prototext.Unmarshal(v, value); err != nil {
+		return fmt.Errorf("failed to unmarshal Config field: %w", err)
+	}
+	m.Config = value
+	return nil
+}
+
+// WithNewConfigForPersistenceExample sets the Config field with a new instance.
+func WithNewConfigForPersistenceExample(opts ...BasicMessageOption) PersistenceExampleOption {
+	return func(m *PersistenceExample) {
+		m.Config = NewBasicMessage(opts...)
+	}
+}
+
+// WithConfig sets the Config field directly.
+func WithConfig(value *BasicMessage) PersistenceExampleOption {
+	return func(m *PersistenceExample) {
+		m.Config = value
+	}
+}
+
+// WithoutConfig clears the Config field.
+func WithoutConfig() PersistenceExampleOption {
+	return func(m *PersistenceExample) {
+		m.Config = nil
+	}
+}
+
+// GetTokenAsBase64 returns the Token field encoded in base64 of the protobuf binary format.
+func (m *PersistenceExample) GetTokenAsBase64() (string, error) {
+	out, err := proto.Marshal(m.Token)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal Token field: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(out), nil
+}
+
+// SetTokenFromBase64 sets the Token field from base64 of the protobuf binary format.
+func (m *PersistenceExample) SetTokenFromBase64(v string) error {
+	in, err := base64.StdEncoding.DecodeString(v)
+	if err != nil {
+		return fmt.Errorf("failed to decode Token field: %w", err)
+	}
+	value := &BasicMessage{}
+	if err := proto.Unmarshal(in, value); err != nil {
+		return fmt.Errorf("failed to unmarshal Token field: %w", err)
+	}
+	m.Token = value
+	return nil
+}
+
+// WithNewTokenForPersistenceExample sets the Token field with a new instance.
+func WithNewTokenForPersistenceExample(opts ...BasicMessageOption) PersistenceExampleOption {
+	return func(m *PersistenceExample) {
+		m.Token = NewBasicMessage(opts...)
+	}
+}
+
+// WithToken sets the Token field directly.
+func WithToken(value *BasicMessage) PersistenceExampleOption {
+	return func(m *PersistenceExample) {
+		m.Token = value
+	}
+}
+
+// WithoutToken clears the Token field.
+func WithoutToken() PersistenceExampleOption {
+	return func(m *PersistenceExample) {
+		m.Token = nil
+	}
+}
+
+// GetNamesAsBinary returns the Names field encoded in the protobuf binary format.
+func (m *PersistenceExample) GetNamesAsBinary() ([]byte, error) {
+	holder := &PersistenceExample{}
+	holder.Names = m.Names
+	out, err := (proto.MarshalOptions{AllowPartial: true}).Marshal(holder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Names field: %w", err)
+	}
+	return out, nil
+}
+
+// SetNamesFromBinary sets the Names field from the protobuf binary format.
+func (m *PersistenceExample) SetNamesFromBinary(v []byte) error {
+	holder := &PersistenceExample{}
+	if err := (proto.UnmarshalOptions{AllowPartial: true}).Unmarshal(v, holder); err != nil {
+		return fmt.Errorf("failed to unmarshal Names field: %w", err)
+	}
+	m.Names = holder.Names
+	return nil
+}
+
+// GetNamesAsText returns the Names field encoded in the protobuf text format.
+func (m *PersistenceExample) GetNamesAsText() ([]byte, error) {
+	holder := &PersistenceExample{}
+	holder.Names = m.Names
+	out, err := (prototext.MarshalOptions{AllowPartial: true}).Marshal(holder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Names field: %w", err)
+	}
+	return out, nil
+}
+
+// SetNamesFromText sets the Names field from the protobuf text format.
+func (m *PersistenceExample) SetNamesFromText(v []byte) error {
+	holder := &PersistenceExample{}
+	if err := (prototext.UnmarshalOptions{AllowPartial: true}).Unmarshal(v, holder); err != nil {
+		return fmt.Errorf("failed to unmarshal Names field: %w", err)
+	}
+	m.Names = holder.Names
+	return nil
+}
+
+// WithNames sets the Names field.
+func WithNames(values ...string) PersistenceExampleOption {
+	return func(m *PersistenceExample) {
+		m.Names = values
+	}
+}
+
+// AddNames appends the values to the Names field.
+func AddNames(values ...string) PersistenceExampleOption {
+	return func(m *PersistenceExample) {
+		m.Names = append(m.Names, values...)
+	}
+}
+
+// WithoutNames clears the Names field.
+func WithoutNames() PersistenceExampleOption {
+	return func(m *PersistenceExample) {
+		m.Names = nil
+	}
+}
+
+// SqlExampleOption defines a functional option for SqlExample.
+type SqlExampleOption = options.Option[*SqlExample]
+
+// NewSqlExample creates a new SqlExample.
+func NewSqlExample(opts ...SqlExampleOption) *SqlExample {
+	m := &SqlExample{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// ApplySqlExampleOptions applies the provided options to an existing SqlExample.
+func ApplySqlExampleOptions(m *SqlExample, opts ...SqlExampleOption) *SqlExample {
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CloneSqlExampleWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneSqlExampleWith(m *SqlExample, opts ...SqlExampleOption) *SqlExample {
+	c := proto.Clone(m).(*SqlExample)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// SqlExampleDocumentSQL stores the Document field of SqlExample through database/sql.
+type SqlExampleDocumentSQL struct {
+	m *SqlExample
+}
+
+// DocumentSQL returns the Document field as a sql.Scanner and driver.Valuer.
+func (m *SqlExample) DocumentSQL() SqlExampleDocumentSQL {
+	return SqlExampleDocumentSQL{m: m}
+}
+
+// Scan implements sql.Scanner, NULL clears the field.
+func (w SqlExampleDocumentSQL) Scan(src any) error {
+	var v []byte
+	switch src := src.(type) {
+	case nil:
+		w.m.Document = nil
+		return nil
+	case []byte:
+		v = src
+	case string:
+		v = []byte(src)
+	default:
+		return fmt.Errorf("cannot scan %T into Document field", src)
+	}
+	value := &BasicMessage{}
+	err := protojson.UnmarshalOptions{}.Unmarshal(v, value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal Document field: %w", err)
+	}
+	w.m.Document = value
+	return nil
+}
+
+// Value implements driver.Valuer, an unset field is stored as NULL.
+func (w SqlExampleDocumentSQL) Value() (driver.Value, error) {
+	if w.m.Document == nil {
+		return nil, nil
+	}
+	out, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(w.m.Document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Document field: %w", err)
+	}
+	return string(out), nil
+}
+
+// WithNewDocumentForSqlExample sets the Document field with a new instance.
+func WithNewDocumentForSqlExample(opts ...BasicMessageOption) SqlExampleOption {
+	return func(m *SqlExample) {
+		m.Document = NewBasicMessage(opts...)
+	}
+}
+
+// WithDocument sets the Document field directly.
+func WithDocument(value *BasicMessage) SqlExampleOption {
+	return func(m *SqlExample) {
+		m.Document = value
+	}
+}
+
+// WithoutDocument clears the Document field.
+func WithoutDocument() SqlExampleOption {
+	return func(m *SqlExample) {
+		m.Document = nil
+	}
+}
+
+// SqlExampleSnapshotSQL stores the Snapshot field of SqlExample through database/sql.
+type SqlExampleSnapshotSQL struct {
+	m *SqlExample
+}
+
+// SnapshotSQL returns the Snapshot field as a sql.Scanner and driver.Valuer.
+func (m *SqlExample) SnapshotSQL() SqlExampleSnapshotSQL {
+	return SqlExampleSnapshotSQL{m: m}
+}
+
+// Scan implements sql.Scanner, NULL clears the field.
+func (w SqlExampleSnapshotSQL) Scan(src any) error {
+	var v []byte
+	switch src := src.(type) {
+	case nil:
+		w.m.Snapshot = nil
+		return nil
+	case []byte:
+		v = src
+	case string:
+		v = []byte(src)
+	default:
+		return fmt.Errorf("cannot scan %T into Snapshot field", src)
+	}
+	value := &BasicMessage{}
+	err := proto.Unmarshal(v, value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal Snapshot field: %w", err)
+	}
+	w.m.Snapshot = value
+	return nil
+}
+
+// Value implements driver.Valuer, an unset field is stored as NULL.
+func (w SqlExampleSnapshotSQL) Value() (driver.Value, error) {
+	if w.m.Snapshot == nil {
+		return nil, nil
+	}
+	out, err := proto.Marshal(w.m.Snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Snapshot field: %w", err)
+	}
+	return out, nil
+}
+
+// WithNewSnapshotForSqlExample sets the Snapshot field with a new instance.
+func WithNewSnapshotForSqlExample(opts ...BasicMessageOption) SqlExampleOption {
+	return func(m *SqlExample) {
+		m.Snapshot = NewBasicMessage(opts...)
+	}
+}
+
+// WithSnapshot sets the Snapshot field directly.
+func WithSnapshot(value *BasicMessage) SqlExampleOption {
+	return func(m *SqlExample) {
+		m.Snapshot = value
+	}
+}
+
+// WithoutSnapshot clears the Snapshot field.
+func WithoutSnapshot() SqlExampleOption {
+	return func(m *SqlExample) {
+		m.Snapshot = nil
+	}
+}
+
+// ValidatedOption defines a functional option for Validated.
+type ValidatedOption = options.Option[*Validated]
+
+// NewValidated creates a new Validated.
+func NewValidated(opts ...ValidatedOption) *Validated {
+	m := &Validated{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// ApplyValidatedOptions applies the provided options to an existing Validated.
+func ApplyValidatedOptions(m *Validated, opts ...ValidatedOption) *Validated {
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CloneValidatedWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneValidatedWith(m *Validated, opts ...ValidatedOption) *Validated {
+	c := proto.Clone(m).(*Validated)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// ValidatedOptionE defines a functional option for Validated that can fail.
+type ValidatedOptionE func(*Validated) error
+
+// ValidatedOptionsE turns the options into a single ValidatedOptionE that never fails.
+func ValidatedOptionsE(opts ...ValidatedOption) ValidatedOptionE {
+	return func(m *Validated) error {
+		for _, opt := range opts {
+			opt(m)
+		}
+		return nil
+	}
+}
+
+// NewValidatedE creates a new Validated, it stops at the first option returning an error.
+func NewValidatedE(opts ...ValidatedOptionE) (*Validated, error) {
+	m := &Validated{}
+	for _, opt := range opts {
+		if err := opt(m); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// ApplyValidatedOptionsE applies the provided options to an existing Validated, it stops at the first option returning an error.
+// The options before the failing one remain applied.
+func ApplyValidatedOptionsE(m *Validated, opts ...ValidatedOptionE) (*Validated, error) {
+	for _, opt := range opts {
+		if err := opt(m); err != nil {
+			return m, err
+		}
+	}
+	return m, nil
+}
+
+// WithSeverity sets the Severity field.
+func WithSeverity(value Priority) ValidatedOption {
+	return func(m *Validated) {
+		m.Severity = value.Enum()
+	}
+}
+
+// WithSeverityLow sets the Severity field to PRIORITY_LOW.
+func WithSeverityLow() ValidatedOption {
+	return func(m *Validated) {
+		m.Severity = Priority_PRIORITY_LOW.Enum()
+	}
+}
+
+// WithSeverityHigh sets the Severity field to PRIORITY_HIGH.
+func WithSeverityHigh() ValidatedOption {
+	return func(m *Validated) {
+		m.Severity = Priority_PRIORITY_HIGH.Enum()
+	}
+}
+
+// WithoutSeverity clears the Severity field.
+func WithoutSeverity() ValidatedOption {
+	return func(m *Validated) {
+		m.Severity = nil
+	}
+}
+
+// WithSeverityE sets the Severity field, values not declared in Priority are rejected.
+func WithSeverityE(value Priority) ValidatedOptionE {
+	return func(m *Validated) error {
+		if value.Descriptor().Values().ByNumber(value.Number()) == nil {
+			return fmt.Errorf("WithSeverityE: %d is not a valid Priority", value)
+		}
+		m.Severity = value.Enum()
+		return nil
+	}
+}
+
+// WithNewStartsAtForValidated sets the StartsAt field with a new instance.
+func WithNewStartsAtForValidated(v time.Time) ValidatedOption {
+	return func(m *Validated) {
+		m.StartsAt = timestamppb.New(v)
+	}
+}
+
+// WithStartsAt sets the StartsAt field directly.
+func WithStartsAt(value *timestamppb.Timestamp) ValidatedOption {
+	return func(m *Validated) {
+		m.StartsAt = value
+	}
+}
+
+// WithoutStartsAt clears the StartsAt field.
+func WithoutStartsAt() ValidatedOption {
+	return func(m *Validated) {
+		m.StartsAt = nil
+	}
+}
+
+// WithStartsAtE sets the StartsAt field, times outside the range of a Timestamp are rejected.
+func WithStartsAtE(v time.Time) ValidatedOptionE {
+	return func(m *Validated) error {
+		value := timestamppb.New(v)
+		if err := value.CheckValid(); err != nil {
+			return fmt.Errorf("WithStartsAtE: %w", err)
+		}
+		m.StartsAt = value
+		return nil
+	}
+}
+
+// WithNewWindowForValidated sets the Window field with a new instance.
+func WithNewWindowForValidated(opts ...Validated_WindowOption) ValidatedOption {
+	return func(m *Validated) {
+		m.Window = NewValidated_Window(opts...)
+	}
+}
+
+// WithWindow sets the Window field directly.
+func WithWindow(value *Validated_Window) ValidatedOption {
+	return func(m *Validated) {
+		m.Window = value
+	}
+}
+
+// WithoutWindow clears the Window field.
+func WithoutWindow() ValidatedOption {
+	return func(m *Validated) {
+		m.Window = nil
+	}
+}
+
+// WithNewWindowForValidatedE sets the Window field with a new instance, built with its E options.
+func WithNewWindowForValidatedE(opts ...Validated_WindowOptionE) ValidatedOptionE {
+	return func(m *Validated) error {
+		value, err := ApplyValidated_WindowOptionsE(&Validated_Window{}, opts...)
+		if err != nil {
+			return fmt.Errorf("WithNewWindowForValidatedE: %w", err)
+		}
+		m.Window = value
+		return nil
+	}
+}
+
+// Validated_WindowOption defines a functional option for Validated_Window.
+type Validated_WindowOption = options.Option[*Validated_Window]
+
+// NewValidated_Window creates a new Validated_Window.
+func NewValidated_Window(opts ...Validated_WindowOption) *Validated_Window {
+	m := &Validated_Window{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// ApplyValidated_WindowOptions applies the provided options to an existing Validated_Window.
+func ApplyValidated_WindowOptions(m *Validated_Window, opts ...Validated_WindowOption) *Validated_Window {
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CloneValidated_WindowWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneValidated_WindowWith(m *Validated_Window, opts ...Validated_WindowOption) *Validated_Window {
+	c := proto.Clone(m).(*Validated_Window)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Validated_WindowOptionE defines a functional option for Validated_Window that can fail.
+type Validated_WindowOptionE func(*Validated_Window) error
+
+// Validated_WindowOptionsE turns the options into a single Validated_WindowOptionE that never fails.
+func Validated_WindowOptionsE(opts ...Validated_WindowOption) Validated_WindowOptionE {
+	return func(m *Validated_Window) error {
+		for _, opt := range opts {
+			opt(m)
+		}
+		return nil
+	}
+}
+
+// NewValidated_WindowE creates a new Validated_Window, it stops at the first option returning an error.
+func NewValidated_WindowE(opts ...Validated_WindowOptionE) (*Validated_Window, error) {
+	m := &Validated_Window{}
+	for _, opt := range opts {
+		if err := opt(m); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// ApplyValidated_WindowOptionsE applies the provided options to an existing Validated_Window, it stops at the first option returning an error.
+// The options before the failing one remain applied.
+func ApplyValidated_WindowOptionsE(m *Validated_Window, opts ...Validated_WindowOptionE) (*Validated_Window, error) {
+	for _, opt := range opts {
+		if err := opt(m); err != nil {
+			return m, err
+		}
+	}
+	return m, nil
+}
+
+// WithNewLengthForValidated_Window sets the Length field with a new instance.
+func WithNewLengthForValidated_Window(v time.Duration) Validated_WindowOption {
+	return func(m *Validated_Window) {
+		m.Length = durationpb.New(v)
+	}
+}
+
+// WithLength sets the Length field directly.
+func WithLength(value *durationpb.Duration) Validated_WindowOption {
+	return func(m *Validated_Window) {
+		m.Length = value
+	}
+}
+
+// WithoutLength clears the Length field.
+func WithoutLength() Validated_WindowOption {
+	return func(m *Validated_Window) {
+		m.Length = nil
+	}
+}
+
+// WithLengthE sets the Length field, negative durations are rejected.
+func WithLengthE(v time.Duration) Validated_WindowOptionE {
+	return func(m *Validated_Window) error {
+		if v < 0 {
+			return fmt.Errorf("WithLengthE: negative duration %s", v)
+		}
+		value := durationpb.New(v)
+		if err := value.CheckValid(); err != nil {
+			return fmt.Errorf("WithLengthE: %w", err)
+		}
+		m.Length = value
+		return nil
+	}
+}
+
+// AccountOption defines a functional option for Account.
+type AccountOption = options.Option[*Account]
+
+// NewAccount creates a new Account.
+func NewAccount(opts ...AccountOption) *Account {
+	m := &Account{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// ApplyAccountOptions applies the provided options to an existing Account.
+func ApplyAccountOptions(m *Account, opts ...AccountOption) *Account {
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CloneAccountWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneAccountWith(m *Account, opts ...AccountOption) *Account {
+	c := proto.Clone(m).(*Account)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// NewAccountChecked creates a new Account and returns an error when a required field is unset after the options are applied.
+func NewAccountChecked(opts ...AccountOption) (*Account, error) {
+	m := &Account{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	if err := CheckAccountRequired(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// MustNewAccount creates a new Account and panics when a required field is unset after the options are applied.
+func MustNewAccount(opts ...AccountOption) *Account {
+	m, err := NewAccountChecked(opts...)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CheckAccountRequired returns an error when a required field of Account, or of a message set in one of its fields, is unset.
+func CheckAccountRequired(m *Account) error {
+	if r := m.ProtoReflect(); !r.Has(r.Descriptor().Fields().ByName("account_id")) {
+		return fmt.Errorf("account_id: required field is not set")
+	}
+	if v := m.GetCredentials(); v != nil {
+		if err := CheckAccount_CredentialsRequired(v); err != nil {
+			return fmt.Errorf("credentials: %w", err)
+		}
+	}
+	for i, v := range m.GetPreviousCredentials() {
+		if err := CheckAccount_CredentialsRequired(v); err != nil {
+			return fmt.Errorf("previous_credentials[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// WithAccountId sets the AccountId field.
+func WithAccountId(value string) AccountOption {
+	return func(m *Account) {
+		m.AccountId = proto.String(value)
+	}
+}
+
+// WithoutAccountId clears the AccountId field.
+func WithoutAccountId() AccountOption {
+	return func(m *Account) {
+		m.AccountId = nil
+	}
+}
+
+// WithNewCredentialsForAccount sets the Credentials field with a new instance.
+func WithNewCredentialsForAccount(opts ...Account_CredentialsOption) AccountOption {
+	return func(m *Account) {
+		m.Credentials = NewAccount_Credentials(opts...)
+	}
+}
+
+// WithCredentials sets the Credentials field directly.
+func WithCredentials(value *Account_Credentials) AccountOption {
+	return func(m *Account) {
+		m.Credentials = value
+	}
+}
+
+// WithoutCredentials clears the Credentials field.
+func WithoutCredentials() AccountOption {
+	return func(m *Account) {
+		m.Credentials = nil
+	}
+}
+
+// WithPreviousCredentials sets the PreviousCredentials field.
+func WithPreviousCredentials(values ...*Account_Credentials) AccountOption {
+	return func(m *Account) {
+		m.PreviousCredentials = values
+	}
+}
+
+// AddPreviousCredentials appends a new element built from the options to the PreviousCredentials field.
+func AddPreviousCredentials(opts ...Account_CredentialsOption) AccountOption {
+	return func(m *Account) {
+		m.PreviousCredentials = append(m.PreviousCredentials, NewAccount_Credentials(opts...))
+	}
+}
+
+// WithoutPreviousCredentials clears the PreviousCredentials field.
+func WithoutPreviousCredentials() AccountOption {
+	return func(m *Account) {
+		m.PreviousCredentials = nil
+	}
+}
+
+// Account_CredentialsOption defines a functional option for Account_Credentials.
+type Account_CredentialsOption = options.Option[*Account_Credentials]
+
+// NewAccount_Credentials creates a new Account_Credentials.
+func NewAccount_Credentials(opts ...Account_CredentialsOption) *Account_Credentials {
+	m := &Account_Credentials{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// ApplyAccount_CredentialsOptions applies the provided options to an existing Account_Credentials.
+func ApplyAccount_CredentialsOptions(m *Account_Credentials, opts ...Account_CredentialsOption) *Account_Credentials {
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CloneAccount_CredentialsWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneAccount_CredentialsWith(m *Account_Credentials, opts ...Account_CredentialsOption) *Account_Credentials {
+	c := proto.Clone(m).(*Account_Credentials)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// NewAccount_CredentialsChecked creates a new Account_Credentials and returns an error when a required field is unset after the options are applied.
+func NewAccount_CredentialsChecked(opts ...Account_CredentialsOption) (*Account_Credentials, error) {
+	m := &Account_Credentials{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	if err := CheckAccount_CredentialsRequired(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// MustNewAccount_Credentials creates a new Account_Credentials and panics when a required field is unset after the options are applied.
+func MustNewAccount_Credentials(opts ...Account_CredentialsOption) *Account_Credentials {
+	m, err := NewAccount_CredentialsChecked(opts...)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CheckAccount_CredentialsRequired returns an error when a required field of Account_Credentials, or of a message set in one of its fields, is unset.
+func CheckAccount_CredentialsRequired(m *Account_Credentials) error {
+	if r := m.ProtoReflect(); !r.Has(r.Descriptor().Fields().ByName("user")) {
+		return fmt.Errorf("user: required field is not set")
+	}
+	return nil
+}
+
+// WithUser sets the User field.
+func WithUser(value string) Account_CredentialsOption {
+	return func(m *Account_Credentials) {
+		m.User = proto.String(value)
+	}
+}
+
+// WithoutUser clears the User field.
+func WithoutUser() Account_CredentialsOption {
+	return func(m *Account_Credentials) {
+		m.User = nil
+	}
+}
+
+// WithSecret sets the Secret field.
+func WithSecret(value string) Account_CredentialsOption {
+	return func(m *Account_Credentials) {
+		m.Secret = proto.String(value)
+	}
+}
+
+// WithoutSecret clears the Secret field.
+func WithoutSecret() Account_CredentialsOption {
+	return func(m *Account_Credentials) {
+		m.Secret = nil
+	}
+}
+
+// RetryPolicyOption defines a functional option for RetryPolicy.
+type RetryPolicyOption = options.Option[*RetryPolicy]
+
+// NewRetryPolicy creates a new RetryPolicy.
+func NewRetryPolicy(opts ...RetryPolicyOption) *RetryPolicy {
+	m := &RetryPolicy{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// ApplyRetryPolicyOptions applies the provided options to an existing RetryPolicy.
+func ApplyRetryPolicyOptions(m *RetryPolicy, opts ...RetryPolicyOption) *RetryPolicy {
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CloneRetryPolicyWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneRetryPolicyWith(m *RetryPolicy, opts ...RetryPolicyOption) *RetryPolicy {
+	c := proto.Clone(m).(*RetryPolicy)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// NewRetryPolicyWithDefaults creates a new RetryPolicy with the declared defaults set before the options are applied.
+func NewRetryPolicyWithDefaults(opts ...RetryPolicyOption) *RetryPolicy {
+	m := &RetryPolicy{}
+	WithDefaults()(m)
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithDefaults sets every field of RetryPolicy with a declared default to that value.
+func WithDefaults() RetryPolicyOption {
+	return func(m *RetryPolicy) {
+		m.Attempts = proto.Uint32(3)
+		m.Backoff = proto.String("exponential")
+		m.Escalation = Priority_PRIORITY_HIGH.Enum()
+	}
+}
+
+// WithAttempts sets the Attempts field.
+func WithAttempts(value uint32) RetryPolicyOption {
+	return func(m *RetryPolicy) {
+		m.Attempts = proto.Uint32(value)
+	}
+}
+
+// WithoutAttempts clears the Attempts field.
+func WithoutAttempts() RetryPolicyOption {
+	return func(m *RetryPolicy) {
+		m.Attempts = nil
+	}
+}
+
+// WithBackoff sets the Backoff field.
+func WithBackoff(value string) RetryPolicyOption {
+	return func(m *RetryPolicy) {
+		m.Backoff = proto.String(value)
+	}
+}
+
+// WithoutBackoff clears the Backoff field.
+func WithoutBackoff() RetryPolicyOption {
+	return func(m *RetryPolicy) {
+		m.Backoff = nil
+	}
+}
+
+// WithEscalation sets the Escalation field.
+func WithEscalation(value Priority) RetryPolicyOption {
+	return func(m *RetryPolicy) {
+		m.Escalation = value.Enum()
+	}
+}
+
+// WithEscalationLow sets the Escalation field to PRIORITY_LOW.
+func WithEscalationLow() RetryPolicyOption {
+	return func(m *RetryPolicy) {
+		m.Escalation = Priority_PRIORITY_LOW.Enum()
+	}
+}
+
+// WithEscalationHigh sets the Escalation field to PRIORITY_HIGH.
+func WithEscalationHigh() RetryPolicyOption {
+	return func(m *RetryPolicy) {
+		m.Escalation = Priority_PRIORITY_HIGH.Enum()
+	}
+}
+
+// WithoutEscalation clears the Escalation field.
+func WithoutEscalation() RetryPolicyOption {
+	return func(m *RetryPolicy) {
+		m.Escalation = nil
+	}
+}
+
+// WithJitter sets the Jitter field.
+func WithJitter(value float64) RetryPolicyOption {
+	return func(m *RetryPolicy) {
+		m.Jitter = proto.Float64(value)
+	}
+}
+
+// WithoutJitter clears the Jitter field.
+func WithoutJitter() RetryPolicyOption {
+	return func(m *RetryPolicy) {
+		m.Jitter = nil
+	}
+}
+
+// CustomerOption defines a functional option for Customer.
+type CustomerOption = options.Option[*Customer]
+
+// NewCustomer creates a new Customer.
+func NewCustomer(opts ...CustomerOption) *Customer {
+	m := &Customer{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// ApplyCustomerOptions applies the provided options to an existing Customer.
+func ApplyCustomerOptions(m *Customer, opts ...CustomerOption) *Customer {
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CloneCustomerWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneCustomerWith(m *Customer, opts ...CustomerOption) *Customer {
+	c := proto.Clone(m).(*Customer)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithNewIdForCustomer sets the Id field with a new instance.
+func WithNewIdForCustomer() CustomerOption {
+	return func(m *Customer) {
+		m.Id = identifier.NewIdentifier()
+	}
+}
+
+// WithCustomerId sets the Id field directly.
+func WithCustomerId(value *identifier.Identifier) CustomerOption {
+	return func(m *Customer) {
+		m.Id = value
+	}
+}
+
+// WithoutCustomerId clears the Id field.
+func WithoutCustomerId() CustomerOption {
+	return func(m *Customer) {
+		m.Id = nil
+	}
+}
+
+// WithEmail sets the Email field.
+func WithEmail(value string) CustomerOption {
+	return func(m *Customer) {
+		m.Email = proto.String(value)
+	}
+}
+
+// WithoutEmail clears the Email field.
+func WithoutEmail() CustomerOption {
+	return func(m *Customer) {
+		m.Email = nil
+	}
+}
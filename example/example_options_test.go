@@ -1,12 +1,22 @@
 package example
 
 import (
+	"database/sql"
+	"database/sql/driver"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/terwey/protoc-gen-go-options/example/identifier"
+	"github.com/terwey/protoc-gen-go-options/options"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func ExampleNewOneofMessage() {
@@ -61,6 +71,17 @@ func TestNewAndApply(t *testing.T) {
 				IsActive: proto.Bool(true),
 			},
 		},
+		{
+			name: "WithoutAge",
+			opts: []BasicMessageOption{
+				WithName("test"),
+				WithAge(30),
+				WithoutAge(),
+			},
+			want: &BasicMessage{
+				Name: proto.String("test"),
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -103,6 +124,14 @@ func TestOneofMessage(t *testing.T) {
 				Choice: &OneofMessage_Number{Number: 42},
 			},
 		},
+		{
+			name: "WithoutChoice",
+			opts: []OneofMessageOption{
+				WithNumber(42),
+				WithoutChoice(),
+			},
+			want: &OneofMessage{},
+		},
 	}
 
 	for _, tt := range tests {
@@ -164,6 +193,42 @@ func TestComplexMessage(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "AddNestedList",
+			opts: []ComplexMessageOption{
+				WithNestedList(&NestedMessage{Description: proto.String("nested1")}),
+				AddNestedList(WithDescription("nested2")),
+				AddNestedList(WithDescription("nested3")),
+			},
+			want: &ComplexMessage{
+				NestedList: []*NestedMessage{
+					{Description: proto.String("nested1")},
+					{Description: proto.String("nested2")},
+					{Description: proto.String("nested3")},
+				},
+			},
+		},
+		{
+			name: "PutAndMergeMetadata",
+			opts: []ComplexMessageOption{
+				PutMetadata("key1", 1),
+				MergeMetadata(map[string]int32{"key1": 10, "key2": 2}),
+				PutMetadata("key3", 3),
+			},
+			want: &ComplexMessage{
+				Metadata: map[string]int32{"key1": 10, "key2": 2, "key3": 3},
+			},
+		},
+		{
+			name: "WithoutNestedListAndMetadata",
+			opts: []ComplexMessageOption{
+				AddNestedList(WithDescription("nested")),
+				PutMetadata("key", 1),
+				WithoutNestedList(),
+				WithoutMetadata(),
+			},
+			want: &ComplexMessage{},
+		},
 		{
 			name: "WithNewNestedForComplexMessage",
 			opts: []ComplexMessageOption{
@@ -217,6 +282,16 @@ func TestRepeatedFieldsMessage(t *testing.T) {
 				Values: []int32{1, 2, 3},
 			},
 		},
+		{
+			name: "AddTags",
+			opts: []RepeatedFieldsMessageOption{
+				WithTags("default"),
+				AddTags("override1", "override2"),
+			},
+			want: &RepeatedFieldsMessage{
+				Tags: []string{"default", "override1", "override2"},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -484,12 +559,30 @@ func TestFooBarWithEnum(t *testing.T) {
 		{
 			name: "WithStatus",
 			opts: []FooBarWithEnumOption{
-				WithStatus(FooBarWithEnum_ACTIVE.Enum()),
+				WithStatus(FooBarWithEnum_ACTIVE),
 			},
 			want: &FooBarWithEnum{
 				Status: FooBarWithEnum_ACTIVE.Enum(),
 			},
 		},
+		{
+			name: "WithStatusInactive",
+			opts: []FooBarWithEnumOption{
+				WithStatusInactive(),
+			},
+			want: &FooBarWithEnum{
+				Status: FooBarWithEnum_INACTIVE.Enum(),
+			},
+		},
+		{
+			name: "WithStatusUnknown",
+			opts: []FooBarWithEnumOption{
+				WithStatus(FooBarWithEnum_UNKNOWN),
+			},
+			want: &FooBarWithEnum{
+				Status: FooBarWithEnum_UNKNOWN.Enum(),
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -534,3 +627,646 @@ func TestIdentifier(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvelope(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []EnvelopeOption
+		want *Envelope
+	}{
+		{
+			name: "WithNewHeaderForEnvelope",
+			opts: []EnvelopeOption{
+				WithNewHeaderForEnvelope(
+					WithTraceId("trace-1"),
+					WithLabels(map[string]string{"env": "test"}),
+				),
+			},
+			want: &Envelope{
+				Header: &Envelope_Header{
+					TraceId: proto.String("trace-1"),
+					Labels:  map[string]string{"env": "test"},
+				},
+			},
+		},
+		{
+			name: "WithForwarded",
+			opts: []EnvelopeOption{
+				WithForwarded(NewEnvelope_Header(WithTraceId("hop-1"))),
+			},
+			want: &Envelope{
+				Forwarded: []*Envelope_Header{
+					{TraceId: proto.String("hop-1")},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewEnvelope(tt.opts...)
+			if diff := cmp.Diff(got, tt.want, cmp.Comparer(proto.Equal)); diff != "" {
+				t.Errorf("%s (-want +got):\n%s", tt.name, diff)
+			}
+
+			existing := &Envelope{}
+			ApplyEnvelopeOptions(existing, tt.opts...)
+			if diff := cmp.Diff(existing, tt.want, cmp.Comparer(proto.Equal)); diff != "" {
+				t.Errorf("ApplyEnvelopeOptions %s (-want +got):\n%s", tt.name, diff)
+			}
+		})
+	}
+}
+
+func TestSkipExample(t *testing.T) {
+	got := NewSkipExample(WithKept("kept"))
+	want := &SkipExample{Kept: proto.String("kept")}
+	if diff := cmp.Diff(got, want, cmp.Comparer(proto.Equal)); diff != "" {
+		t.Errorf("NewSkipExample() (-want +got):\n%s", diff)
+	}
+}
+
+func TestImplicitPresence(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []ImplicitPresenceOption
+		want *ImplicitPresence
+	}{
+		{
+			name: "WithLabelAndCount",
+			opts: []ImplicitPresenceOption{
+				WithLabel("label"),
+				WithCount(3),
+			},
+			want: &ImplicitPresence{
+				Label: "label",
+				Count: 3,
+			},
+		},
+		{
+			name: "WithPayloadAndPriority",
+			opts: []ImplicitPresenceOption{
+				WithPayload([]byte("payload")),
+				WithPriorityHigh(),
+			},
+			want: &ImplicitPresence{
+				Payload:  []byte("payload"),
+				Priority: Priority_PRIORITY_HIGH,
+			},
+		},
+		{
+			name: "Without",
+			opts: []ImplicitPresenceOption{
+				WithLabel("label"),
+				WithCount(3),
+				WithPriorityLow(),
+				WithoutLabel(),
+				WithoutCount(),
+				WithoutPriority(),
+			},
+			want: &ImplicitPresence{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewImplicitPresence(tt.opts...)
+			if diff := cmp.Diff(got, tt.want, cmp.Comparer(proto.Equal)); diff != "" {
+				t.Errorf("%s (-want +got):\n%s", tt.name, diff)
+			}
+
+			existing := &ImplicitPresence{}
+			ApplyImplicitPresenceOptions(existing, tt.opts...)
+			if diff := cmp.Diff(existing, tt.want, cmp.Comparer(proto.Equal)); diff != "" {
+				t.Errorf("ApplyImplicitPresenceOptions %s (-want +got):\n%s", tt.name, diff)
+			}
+		})
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	at := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		opts []ScheduleOption
+		want *Schedule
+	}{
+		{
+			name: "WithNewAtForSchedule",
+			opts: []ScheduleOption{
+				WithNewAtForSchedule(at),
+			},
+			want: &Schedule{
+				When: &Schedule_At{At: timestamppb.New(at)},
+			},
+		},
+		{
+			name: "WithNewEveryForSchedule",
+			opts: []ScheduleOption{
+				WithNewEveryForSchedule(time.Minute),
+			},
+			want: &Schedule{
+				When: &Schedule_Every{Every: durationpb.New(time.Minute)},
+			},
+		},
+		{
+			name: "WithNewOwnerForSchedule",
+			opts: []ScheduleOption{
+				WithNewAtForSchedule(at),
+				WithNewOwnerForSchedule(WithName("owner")),
+			},
+			want: &Schedule{
+				When: &Schedule_Owner{Owner: &BasicMessage{Name: proto.String("owner")}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewSchedule(tt.opts...)
+			if diff := cmp.Diff(got, tt.want, cmp.Comparer(proto.Equal)); diff != "" {
+				t.Errorf("%s (-want +got):\n%s", tt.name, diff)
+			}
+
+			existing := &Schedule{}
+			ApplyScheduleOptions(existing, tt.opts...)
+			if diff := cmp.Diff(existing, tt.want, cmp.Comparer(proto.Equal)); diff != "" {
+				t.Errorf("ApplyScheduleOptions %s (-want +got):\n%s", tt.name, diff)
+			}
+		})
+	}
+}
+
+func TestScheduleTimeCollections(t *testing.T) {
+	start := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	msg := NewSchedule(
+		WithNewEventTimesForSchedule(start, start.Add(time.Hour)),
+		WithNewTimeoutsForSchedule(map[string]time.Duration{"connect": time.Second, "read": time.Minute}),
+	)
+
+	want := &Schedule{
+		EventTimes: []*timestamppb.Timestamp{timestamppb.New(start), timestamppb.New(start.Add(time.Hour))},
+		Timeouts: map[string]*durationpb.Duration{
+			"connect": durationpb.New(time.Second),
+			"read":    durationpb.New(time.Minute),
+		},
+	}
+	if diff := cmp.Diff(want, msg, cmp.Comparer(proto.Equal)); diff != "" {
+		t.Errorf("NewSchedule (-want +got):\n%s", diff)
+	}
+
+	// the conversions replace the field, appending still goes through the message forms
+	ApplyScheduleOptions(msg, AddEventTimes(timestamppb.New(start.Add(2*time.Hour))), WithNewTimeoutsForSchedule(nil))
+	if len(msg.GetEventTimes()) != 3 || len(msg.GetTimeouts()) != 0 {
+		t.Errorf("ApplyScheduleOptions got %v", msg)
+	}
+}
+
+func TestProtojsonExample(t *testing.T) {
+	msg := NewProtojsonExample(
+		WithBasicForProtojsonExample(NewBasicMessage(WithName("basic"))),
+		WithIds(1, 2),
+		WithLevelHigh(),
+	)
+
+	tests := []struct {
+		name string
+		get  func() ([]byte, error)
+		want string
+	}{
+		{name: "message", get: msg.GetBasicAsJSON, want: `{"name":"basic"}`},
+		{name: "int64 as strings", get: msg.GetIdsAsJSON, want: `["1","2"]`},
+		{name: "enum by name", get: msg.GetLevelAsJSON, want: `"PRIORITY_HIGH"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.get()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, strings.ReplaceAll(string(got), " ", "")); diff != "" {
+				t.Errorf("%s (-want +got):\n%s", tt.name, diff)
+			}
+		})
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		got := &ProtojsonExample{}
+		for _, set := range []struct {
+			get func() ([]byte, error)
+			set func([]byte) error
+		}{
+			{msg.GetBasicAsJSON, got.SetBasicFromJSON},
+			{msg.GetIdsAsJSON, got.SetIdsFromJSON},
+			{msg.GetLevelAsJSON, got.SetLevelFromJSON},
+		} {
+			b, err := set.get()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := set.set(b); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if diff := cmp.Diff(got, msg, cmp.Comparer(proto.Equal)); diff != "" {
+			t.Errorf("round trip (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		got, err := (&ProtojsonExample{}).GetIdsAsJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != "null" {
+			t.Errorf("got %s, want null", got)
+		}
+	})
+}
+
+func TestPersistenceExample(t *testing.T) {
+	msg := NewPersistenceExample(
+		WithBlob(NewBasicMessage(WithName("blob"))),
+		WithConfig(NewBasicMessage(WithName("config"), WithAge(3))),
+		WithToken(NewBasicMessage(WithIsActive(true))),
+		WithNames("a", "b"),
+	)
+
+	got := &PersistenceExample{}
+
+	blob, err := msg.GetBlobAsBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := got.SetBlobFromBinary(blob); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := msg.GetConfigAsText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `name:"config" age:3`; strings.Join(strings.Fields(string(config)), "") != strings.ReplaceAll(want, " ", "") {
+		t.Errorf("GetConfigAsText() = %s, want %s", config, want)
+	}
+	if err := got.SetConfigFromText(config); err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := msg.GetTokenAsBase64()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := got.SetTokenFromBase64(token); err != nil {
+		t.Fatal(err)
+	}
+	if err := got.SetTokenFromBase64("not base64!"); err == nil {
+		t.Error("SetTokenFromBase64 accepted invalid base64")
+	}
+
+	names, err := msg.GetNamesAsText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := got.SetNamesFromText(names); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(got, msg, cmp.Comparer(proto.Equal)); diff != "" {
+		t.Errorf("round trip (-want +got):\n%s", diff)
+	}
+}
+
+func TestSqlExample(t *testing.T) {
+	msg := NewSqlExample(
+		WithDocument(NewBasicMessage(WithName("doc"), WithIsActive(true))),
+		WithSnapshot(NewBasicMessage(WithAge(3))),
+	)
+
+	// the wrappers have to satisfy database/sql
+	var _ sql.Scanner = msg.DocumentSQL()
+	var _ driver.Valuer = msg.SnapshotSQL()
+
+	document, err := msg.DocumentSQL().Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, ok := document.(string); !ok || !strings.Contains(s, `"is_active"`) {
+		t.Errorf("DocumentSQL().Value() = %#v, want a protojson string using proto names", document)
+	}
+	snapshot, err := msg.SnapshotSQL().Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := snapshot.([]byte); !ok {
+		t.Errorf("SnapshotSQL().Value() = %#v, want []byte", snapshot)
+	}
+
+	got := &SqlExample{}
+	// drivers return jsonb columns as []byte
+	if err := got.DocumentSQL().Scan([]byte(document.(string))); err != nil {
+		t.Fatal(err)
+	}
+	if err := got.SnapshotSQL().Scan(snapshot); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(got, msg, cmp.Comparer(proto.Equal)); diff != "" {
+		t.Errorf("round trip (-want +got):\n%s", diff)
+	}
+
+	t.Run("null", func(t *testing.T) {
+		if err := got.DocumentSQL().Scan(nil); err != nil {
+			t.Fatal(err)
+		}
+		if got.Document != nil {
+			t.Errorf("Scan(nil) left Document = %v", got.Document)
+		}
+		v, err := got.DocumentSQL().Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v != nil {
+			t.Errorf("Value() of an unset field = %#v, want nil", v)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		if err := got.DocumentSQL().Scan(42); err == nil {
+			t.Error("Scan accepted an int64")
+		}
+		if err := got.DocumentSQL().Scan([]byte("{")); err == nil {
+			t.Error("Scan accepted invalid JSON")
+		}
+	})
+}
+
+func TestValidatedE(t *testing.T) {
+	start := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	msg, err := NewValidatedE(
+		WithSeverityE(Priority_PRIORITY_HIGH),
+		WithStartsAtE(start),
+		WithNewWindowForValidatedE(WithLengthE(time.Hour)),
+		// the regular options can be mixed in
+		ValidatedOptionsE(WithoutStartsAt(), WithNewStartsAtForValidated(start)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Validated{
+		Severity: Priority_PRIORITY_HIGH.Enum(),
+		StartsAt: timestamppb.New(start),
+		Window:   &Validated_Window{Length: durationpb.New(time.Hour)},
+	}
+	if diff := cmp.Diff(want, msg, cmp.Comparer(proto.Equal)); diff != "" {
+		t.Errorf("NewValidatedE (-want +got):\n%s", diff)
+	}
+
+	tests := []struct {
+		name    string
+		opts    []ValidatedOptionE
+		wantErr string
+	}{
+		{name: "enum", opts: []ValidatedOptionE{WithSeverityE(Priority(42))}, wantErr: "WithSeverityE: 42 is not a valid Priority"},
+		{name: "timestamp", opts: []ValidatedOptionE{WithStartsAtE(time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC))}, wantErr: "WithStartsAtE: "},
+		{name: "nested", opts: []ValidatedOptionE{WithNewWindowForValidatedE(WithLengthE(-time.Second))}, wantErr: "WithNewWindowForValidatedE: WithLengthE: negative duration -1s"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := NewValidatedE(tt.opts...)
+			if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+				t.Errorf("got error %v, want %q", err, tt.wantErr)
+			}
+			if msg != nil {
+				t.Errorf("got message %v on error", msg)
+			}
+		})
+	}
+
+	t.Run("apply stops at the first error", func(t *testing.T) {
+		msg := NewValidated(WithSeverityLow())
+		_, err := ApplyValidatedOptionsE(msg, WithSeverityE(Priority(42)), WithStartsAtE(start))
+		if err == nil {
+			t.Fatal("ApplyValidatedOptionsE accepted an undeclared enum value")
+		}
+		if msg.GetSeverity() != Priority_PRIORITY_LOW || msg.StartsAt != nil {
+			t.Errorf("options were applied after the failing one: %v", msg)
+		}
+	})
+}
+
+func TestAccountChecked(t *testing.T) {
+	msg, err := NewAccountChecked(
+		WithAccountId("acct-1"),
+		WithNewCredentialsForAccount(WithUser("alice"), WithSecret("s3cret")),
+		AddPreviousCredentials(WithUser("bob")),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := proto.Marshal(msg); err != nil {
+		t.Errorf("marshalling a checked message: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		opts    []AccountOption
+		wantErr string
+	}{
+		{name: "field", opts: nil, wantErr: "account_id: required field is not set"},
+		{name: "nested", opts: []AccountOption{WithAccountId("acct-1"), WithNewCredentialsForAccount(WithSecret("s3cret"))}, wantErr: "credentials: user: required field is not set"},
+		{name: "repeated", opts: []AccountOption{WithAccountId("acct-1"), AddPreviousCredentials(WithUser("bob")), AddPreviousCredentials()}, wantErr: "previous_credentials[1]: user: required field is not set"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := NewAccountChecked(tt.opts...)
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("got error %v, want %q", err, tt.wantErr)
+			}
+			if msg != nil {
+				t.Errorf("got message %v on error", msg)
+			}
+			// proto.Marshal rejects the same messages
+			if _, err := proto.Marshal(NewAccount(tt.opts...)); err == nil {
+				t.Error("proto.Marshal accepted a message with an unset required field")
+			}
+		})
+	}
+
+	t.Run("must", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("MustNewAccount did not panic on an unset required field")
+			}
+		}()
+		MustNewAccount(WithNewCredentialsForAccount(WithUser("alice")))
+	})
+}
+
+func TestRetryPolicyWithDefaults(t *testing.T) {
+	msg := NewRetryPolicyWithDefaults(WithAttempts(5))
+	want := &RetryPolicy{
+		Attempts:   proto.Uint32(5),
+		Backoff:    proto.String("exponential"),
+		Escalation: Priority_PRIORITY_HIGH.Enum(),
+	}
+	if diff := cmp.Diff(want, msg, cmp.Comparer(proto.Equal)); diff != "" {
+		t.Errorf("NewRetryPolicyWithDefaults (-want +got):\n%s", diff)
+	}
+
+	// the getters of an empty message return the same defaults, but they are not serialized
+	empty := NewRetryPolicy()
+	if empty.GetBackoff() != "exponential" || empty.Backoff != nil {
+		t.Errorf("got backoff %q (%v), want the default from the getter only", empty.GetBackoff(), empty.Backoff)
+	}
+	b, err := proto.Marshal(ApplyRetryPolicyOptions(empty, WithDefaults()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded RetryPolicy
+	if err := proto.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Attempts == nil || decoded.GetAttempts() != 3 || decoded.Jitter != nil {
+		t.Errorf("got %v after serialization, want only the declared defaults", &decoded)
+	}
+}
+
+// traced records the name of every option it wraps when applied, it accepts the options of every generated message.
+func traced[T proto.Message](trace *[]string, name string, opt options.Option[T]) options.Option[T] {
+	return func(m T) {
+		*trace = append(*trace, name)
+		opt(m)
+	}
+}
+
+func TestGenericOptions(t *testing.T) {
+	var trace []string
+	verbose := false
+	basic := NewBasicMessage(
+		traced(&trace, "name", WithName("Alice")),
+		options.When(verbose, WithIsActive(true)),
+		options.Compose(WithAge(30), traced(&trace, "age", WithAge(31))),
+	)
+	want := &BasicMessage{Name: proto.String("Alice"), Age: proto.Int32(31)}
+	if diff := cmp.Diff(want, basic, cmp.Comparer(proto.Equal)); diff != "" {
+		t.Errorf("NewBasicMessage (-want +got):\n%s", diff)
+	}
+
+	// the same helpers work with the options of any other message
+	header := options.Apply(&Envelope_Header{}, traced(&trace, "trace id", WithTraceId("abc")))
+	if header.GetTraceId() != "abc" {
+		t.Errorf("got trace id %q, want abc", header.GetTraceId())
+	}
+	if diff := cmp.Diff([]string{"name", "age", "trace id"}, trace); diff != "" {
+		t.Errorf("trace (-want +got):\n%s", diff)
+	}
+}
+
+func TestBuilders(t *testing.T) {
+	basic := NewBasicMessageBuilder().Name("x").Age(3).Build()
+	if diff := cmp.Diff(NewBasicMessage(WithName("x"), WithAge(3)), basic, cmp.Comparer(proto.Equal)); diff != "" {
+		t.Errorf("BasicMessageBuilder (-want +got):\n%s", diff)
+	}
+
+	builder := NewComplexMessageBuilder().
+		Nested().
+		Description("outer").
+		Basic().Name("y").IsActive(true).Done().
+		Done().
+		Metadata(map[string]int32{"a": 1}).
+		Apply(PutMetadata("b", 2))
+	want := &ComplexMessage{
+		Nested: &NestedMessage{
+			Basic:       &BasicMessage{Name: proto.String("y"), IsActive: proto.Bool(true)},
+			Description: proto.String("outer"),
+		},
+		Metadata: map[string]int32{"a": 1, "b": 2},
+	}
+	first := builder.Build()
+	if diff := cmp.Diff(want, first, cmp.Comparer(proto.Equal)); diff != "" {
+		t.Errorf("ComplexMessageBuilder (-want +got):\n%s", diff)
+	}
+
+	// every Build returns a fresh copy, later changes to the builder do not leak into built messages
+	builder.Nested().Basic().Age(42)
+	if first.GetNested().GetBasic().Age != nil {
+		t.Errorf("building further changed a built message: %v", first)
+	}
+	if got := builder.Build().GetNested().GetBasic().GetAge(); got != 42 {
+		t.Errorf("got age %d, want 42 set on the existing nested message", got)
+	}
+}
+
+func TestCloneWith(t *testing.T) {
+	template := NewNestedMessage(WithNewBasicForNestedMessage(WithName("template")), WithDescription("shared"))
+
+	c := CloneNestedMessageWith(template, WithDescription("copy"))
+	c.GetBasic().Age = proto.Int32(7)
+	if template.GetDescription() != "shared" || template.GetBasic().Age != nil {
+		t.Errorf("the original was modified: %v", template)
+	}
+	want := &NestedMessage{Basic: &BasicMessage{Name: proto.String("template"), Age: proto.Int32(7)}, Description: proto.String("copy")}
+	if diff := cmp.Diff(want, c, cmp.Comparer(proto.Equal)); diff != "" {
+		t.Errorf("CloneNestedMessageWith (-want +got):\n%s", diff)
+	}
+
+	// the generic helper derives copies of any generated message
+	basic := options.CloneWith(template.GetBasic(), WithIsActive(true))
+	if !basic.GetIsActive() || template.GetBasic().IsActive != nil {
+		t.Errorf("options.CloneWith got %v, original %v", basic, template.GetBasic())
+	}
+}
+
+func TestWellKnownConveniences(t *testing.T) {
+	attributes, err := WithNewAttributesForWellKnown(map[string]any{"region": "eu", "replicas": 3})
+	if err != nil {
+		t.Fatalf("WithNewAttributesForWellKnown: %v", err)
+	}
+	attachment, err := WithNewAttachmentForWellKnown(NewBasicMessage(WithName("attached")))
+	if err != nil {
+		t.Fatalf("WithNewAttachmentForWellKnown: %v", err)
+	}
+	msg := NewWellKnown(WithNewMaxRetriesForWellKnown(3), attributes, attachment, WithNewPingForWellKnown())
+
+	want := &WellKnown{
+		MaxRetries: wrapperspb.Int32(3),
+		Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+			"region":   structpb.NewStringValue("eu"),
+			"replicas": structpb.NewNumberValue(3),
+		}},
+		Ping: &emptypb.Empty{},
+	}
+	got := proto.Clone(msg).(*WellKnown)
+	got.Attachment = nil
+	if diff := cmp.Diff(want, got, cmp.Comparer(proto.Equal)); diff != "" {
+		t.Errorf("NewWellKnown (-want +got):\n%s", diff)
+	}
+	unpacked, err := msg.GetAttachment().UnmarshalNew()
+	if err != nil {
+		t.Fatalf("unpacking the attachment: %v", err)
+	}
+	if diff := cmp.Diff(NewBasicMessage(WithName("attached")), unpacked, cmp.Comparer(proto.Equal)); diff != "" {
+		t.Errorf("attachment (-want +got):\n%s", diff)
+	}
+
+	if _, err := WithNewAttributesForWellKnown(map[string]any{"channel": make(chan int)}); err == nil {
+		t.Error("WithNewAttributesForWellKnown accepted a value that cannot be converted")
+	}
+}
+
+func TestCustomerExplicitName(t *testing.T) {
+	id := identifier.NewIdentifier()
+	got := NewCustomer(WithCustomerId(id), WithEmail("ada@example.com"))
+	want := &Customer{Id: id, Email: proto.String("ada@example.com")}
+	if diff := cmp.Diff(want, got, cmp.Comparer(proto.Equal)); diff != "" {
+		t.Errorf("NewCustomer (-want +got):\n%s", diff)
+	}
+
+	ApplyCustomerOptions(got, WithoutCustomerId())
+	if got.GetId() != nil {
+		t.Errorf("WithoutCustomerId left %v", got.GetId())
+	}
+}
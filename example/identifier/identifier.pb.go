@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.1
+// 	protoc-gen-go v1.36.3
 // 	protoc        v5.29.2
 // source: identifier.proto
 
@@ -21,7 +21,8 @@ const (
 )
 
 // Identifier exists so we have to import an external proto and can generate two packages
-// If your message should be optionless you can mark it with the comment option
+// If your message should be optionless you can mark it with the comment option,
+// this is deprecated in favour of option (go_options.message).optionless = true
 // GO_OPTIONS_OPTIONLESS
 type Identifier struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
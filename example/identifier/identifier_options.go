@@ -3,11 +3,12 @@
 package identifier
 
 import (
-	"google.golang.org/protobuf/proto"
+	options "github.com/terwey/protoc-gen-go-options/options"
+	proto "google.golang.org/protobuf/proto"
 )
 
 // IdentifierOption defines a functional option for Identifier.
-type IdentifierOption func(*Identifier)
+type IdentifierOption = options.Option[*Identifier]
 
 // NewIdentifier creates a new Identifier.
 func NewIdentifier(opts ...IdentifierOption) *Identifier {
@@ -24,3 +25,10 @@ func WithId(value string) IdentifierOption {
 		m.Id = proto.String(value)
 	}
 }
+
+// WithoutId clears the Id field.
+func WithoutId() IdentifierOption {
+	return func(m *Identifier) {
+		m.Id = nil
+	}
+}
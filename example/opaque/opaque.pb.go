@@ -0,0 +1,571 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.3
+// 	protoc        v5.29.2
+// source: opaque/opaque.proto
+
+package opaque
+
+import (
+	_ "github.com/terwey/protoc-gen-go-options/gooptions"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Level int32
+
+const (
+	Level_LEVEL_UNSPECIFIED Level = 0
+	Level_LEVEL_DEBUG       Level = 1
+	Level_LEVEL_ERROR       Level = 2
+)
+
+// Enum value maps for Level.
+var (
+	Level_name = map[int32]string{
+		0: "LEVEL_UNSPECIFIED",
+		1: "LEVEL_DEBUG",
+		2: "LEVEL_ERROR",
+	}
+	Level_value = map[string]int32{
+		"LEVEL_UNSPECIFIED": 0,
+		"LEVEL_DEBUG":       1,
+		"LEVEL_ERROR":       2,
+	}
+)
+
+func (x Level) Enum() *Level {
+	p := new(Level)
+	*p = x
+	return p
+}
+
+func (x Level) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Level) Descriptor() protoreflect.EnumDescriptor {
+	return file_opaque_opaque_proto_enumTypes[0].Descriptor()
+}
+
+func (Level) Type() protoreflect.EnumType {
+	return &file_opaque_opaque_proto_enumTypes[0]
+}
+
+func (x Level) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+type Detail struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Reason      *string                `protobuf:"bytes,1,opt,name=reason"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Detail) Reset() {
+	*x = Detail{}
+	mi := &file_opaque_opaque_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Detail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Detail) ProtoMessage() {}
+
+func (x *Detail) ProtoReflect() protoreflect.Message {
+	mi := &file_opaque_opaque_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Detail) GetReason() string {
+	if x != nil {
+		if x.xxx_hidden_Reason != nil {
+			return *x.xxx_hidden_Reason
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Detail) SetReason(v string) {
+	x.xxx_hidden_Reason = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *Detail) HasReason() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Detail) ClearReason() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Reason = nil
+}
+
+type Detail_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Reason *string
+}
+
+func (b0 Detail_builder) Build() *Detail {
+	m0 := &Detail{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Reason != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Reason = b.Reason
+	}
+	return m0
+}
+
+type Event struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,1,opt,name=name"`
+	xxx_hidden_Attempts    int32                  `protobuf:"varint,2,opt,name=attempts"`
+	xxx_hidden_Level       Level                  `protobuf:"varint,3,opt,name=level,enum=opaque.Level"`
+	xxx_hidden_Tags        []string               `protobuf:"bytes,4,rep,name=tags"`
+	xxx_hidden_Counters    map[string]int32       `protobuf:"bytes,5,rep,name=counters" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	xxx_hidden_Detail      *Detail                `protobuf:"bytes,6,opt,name=detail"`
+	xxx_hidden_SeenAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=seen_at,json=seenAt"`
+	xxx_hidden_Payload     isEvent_Payload        `protobuf_oneof:"payload"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_opaque_opaque_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_opaque_opaque_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Event) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Event) GetAttempts() int32 {
+	if x != nil {
+		return x.xxx_hidden_Attempts
+	}
+	return 0
+}
+
+func (x *Event) GetLevel() Level {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 2) {
+			return x.xxx_hidden_Level
+		}
+	}
+	return Level_LEVEL_UNSPECIFIED
+}
+
+func (x *Event) GetTags() []string {
+	if x != nil {
+		return x.xxx_hidden_Tags
+	}
+	return nil
+}
+
+func (x *Event) GetCounters() map[string]int32 {
+	if x != nil {
+		return x.xxx_hidden_Counters
+	}
+	return nil
+}
+
+func (x *Event) GetDetail() *Detail {
+	if x != nil {
+		return x.xxx_hidden_Detail
+	}
+	return nil
+}
+
+func (x *Event) GetSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_SeenAt
+	}
+	return nil
+}
+
+func (x *Event) GetText() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Payload.(*event_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *Event) GetStructured() *Detail {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Payload.(*event_Structured); ok {
+			return x.Structured
+		}
+	}
+	return nil
+}
+
+func (x *Event) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 8)
+}
+
+func (x *Event) SetAttempts(v int32) {
+	x.xxx_hidden_Attempts = v
+}
+
+func (x *Event) SetLevel(v Level) {
+	x.xxx_hidden_Level = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 8)
+}
+
+func (x *Event) SetTags(v []string) {
+	x.xxx_hidden_Tags = v
+}
+
+func (x *Event) SetCounters(v map[string]int32) {
+	x.xxx_hidden_Counters = v
+}
+
+func (x *Event) SetDetail(v *Detail) {
+	x.xxx_hidden_Detail = v
+}
+
+func (x *Event) SetSeenAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_SeenAt = v
+}
+
+func (x *Event) SetText(v string) {
+	x.xxx_hidden_Payload = &event_Text{v}
+}
+
+func (x *Event) SetStructured(v *Detail) {
+	if v == nil {
+		x.xxx_hidden_Payload = nil
+		return
+	}
+	x.xxx_hidden_Payload = &event_Structured{v}
+}
+
+func (x *Event) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Event) HasLevel() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Event) HasDetail() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Detail != nil
+}
+
+func (x *Event) HasSeenAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_SeenAt != nil
+}
+
+func (x *Event) HasPayload() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Payload != nil
+}
+
+func (x *Event) HasText() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Payload.(*event_Text)
+	return ok
+}
+
+func (x *Event) HasStructured() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Payload.(*event_Structured)
+	return ok
+}
+
+func (x *Event) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *Event) ClearLevel() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Level = Level_LEVEL_UNSPECIFIED
+}
+
+func (x *Event) ClearDetail() {
+	x.xxx_hidden_Detail = nil
+}
+
+func (x *Event) ClearSeenAt() {
+	x.xxx_hidden_SeenAt = nil
+}
+
+func (x *Event) ClearPayload() {
+	x.xxx_hidden_Payload = nil
+}
+
+func (x *Event) ClearText() {
+	if _, ok := x.xxx_hidden_Payload.(*event_Text); ok {
+		x.xxx_hidden_Payload = nil
+	}
+}
+
+func (x *Event) ClearStructured() {
+	if _, ok := x.xxx_hidden_Payload.(*event_Structured); ok {
+		x.xxx_hidden_Payload = nil
+	}
+}
+
+const Event_Payload_not_set_case case_Event_Payload = 0
+const Event_Text_case case_Event_Payload = 8
+const Event_Structured_case case_Event_Payload = 9
+
+func (x *Event) WhichPayload() case_Event_Payload {
+	if x == nil {
+		return Event_Payload_not_set_case
+	}
+	switch x.xxx_hidden_Payload.(type) {
+	case *event_Text:
+		return Event_Text_case
+	case *event_Structured:
+		return Event_Structured_case
+	default:
+		return Event_Payload_not_set_case
+	}
+}
+
+type Event_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name     *string
+	Attempts int32
+	Level    *Level
+	Tags     []string
+	Counters map[string]int32
+	Detail   *Detail
+	SeenAt   *timestamppb.Timestamp
+	// Fields of oneof xxx_hidden_Payload:
+	Text       *string
+	Structured *Detail
+	// -- end of xxx_hidden_Payload
+}
+
+func (b0 Event_builder) Build() *Event {
+	m0 := &Event{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 8)
+		x.xxx_hidden_Name = b.Name
+	}
+	x.xxx_hidden_Attempts = b.Attempts
+	if b.Level != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 8)
+		x.xxx_hidden_Level = *b.Level
+	}
+	x.xxx_hidden_Tags = b.Tags
+	x.xxx_hidden_Counters = b.Counters
+	x.xxx_hidden_Detail = b.Detail
+	x.xxx_hidden_SeenAt = b.SeenAt
+	if b.Text != nil {
+		x.xxx_hidden_Payload = &event_Text{*b.Text}
+	}
+	if b.Structured != nil {
+		x.xxx_hidden_Payload = &event_Structured{b.Structured}
+	}
+	return m0
+}
+
+type case_Event_Payload protoreflect.FieldNumber
+
+func (x case_Event_Payload) String() string {
+	md := file_opaque_opaque_proto_msgTypes[1].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isEvent_Payload interface {
+	isEvent_Payload()
+}
+
+type event_Text struct {
+	Text string `protobuf:"bytes,8,opt,name=text,oneof"`
+}
+
+type event_Structured struct {
+	Structured *Detail `protobuf:"bytes,9,opt,name=structured,oneof"`
+}
+
+func (*event_Text) isEvent_Payload() {}
+
+func (*event_Structured) isEvent_Payload() {}
+
+var File_opaque_opaque_proto protoreflect.FileDescriptor
+
+var file_opaque_opaque_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x2f, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x1a, 0x1a, 0x67,
+	0x6f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x6f, 0x5f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x20, 0x0a, 0x06, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xa7, 0x03, 0x0a,
+	0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x05, 0xaa, 0x01,
+	0x02, 0x08, 0x02, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x23, 0x0a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6f,
+	0x70, 0x61, 0x71, 0x75, 0x65, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x61, 0x71, 0x75,
+	0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x30, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x42,
+	0x08, 0xa2, 0x86, 0x19, 0x04, 0x08, 0x01, 0x20, 0x01, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x12, 0x33, 0x0a, 0x07, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06,
+	0x73, 0x65, 0x65, 0x6e, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x30, 0x0a, 0x0a,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x1a, 0x3b,
+	0x0a, 0x0d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2a, 0x40, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x15, 0x0a, 0x11, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f,
+	0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x45, 0x56, 0x45, 0x4c,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x72, 0x77, 0x65, 0x79, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x6f, 0x70, 0x61, 0x71,
+	0x75, 0x65, 0x3b, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x62, 0x08, 0x65, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x70, 0xe8, 0x07,
+}
+
+var file_opaque_opaque_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_opaque_opaque_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_opaque_opaque_proto_goTypes = []any{
+	(Level)(0),                    // 0: opaque.Level
+	(*Detail)(nil),                // 1: opaque.Detail
+	(*Event)(nil),                 // 2: opaque.Event
+	nil,                           // 3: opaque.Event.CountersEntry
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_opaque_opaque_proto_depIdxs = []int32{
+	0, // 0: opaque.Event.level:type_name -> opaque.Level
+	3, // 1: opaque.Event.counters:type_name -> opaque.Event.CountersEntry
+	1, // 2: opaque.Event.detail:type_name -> opaque.Detail
+	4, // 3: opaque.Event.seen_at:type_name -> google.protobuf.Timestamp
+	1, // 4: opaque.Event.structured:type_name -> opaque.Detail
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_opaque_opaque_proto_init() }
+func file_opaque_opaque_proto_init() {
+	if File_opaque_opaque_proto != nil {
+		return
+	}
+	file_opaque_opaque_proto_msgTypes[1].OneofWrappers = []any{
+		(*event_Text)(nil),
+		(*event_Structured)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_opaque_opaque_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_opaque_opaque_proto_goTypes,
+		DependencyIndexes: file_opaque_opaque_proto_depIdxs,
+		EnumInfos:         file_opaque_opaque_proto_enumTypes,
+		MessageInfos:      file_opaque_opaque_proto_msgTypes,
+	}.Build()
+	File_opaque_opaque_proto = out.File
+	file_opaque_opaque_proto_rawDesc = nil
+	file_opaque_opaque_proto_goTypes = nil
+	file_opaque_opaque_proto_depIdxs = nil
+}
@@ -0,0 +1,306 @@
+// Code generated by protoc-gen-go-options. DO NOT EDIT.
+// source: opaque/opaque.proto
+package opaque
+
+import (
+	fmt "fmt"
+	options "github.com/terwey/protoc-gen-go-options/options"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	proto "google.golang.org/protobuf/proto"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	time "time"
+)
+
+// DetailOption defines a functional option for Detail.
+type DetailOption = options.Option[*Detail]
+
+// NewDetail creates a new Detail.
+func NewDetail(opts ...DetailOption) *Detail {
+	m := &Detail{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// ApplyDetailOptions applies the provided options to an existing Detail.
+func ApplyDetailOptions(m *Detail, opts ...DetailOption) *Detail {
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CloneDetailWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneDetailWith(m *Detail, opts ...DetailOption) *Detail {
+	c := proto.Clone(m).(*Detail)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithReason sets the Reason field.
+func WithReason(value string) DetailOption {
+	return func(m *Detail) {
+		m.SetReason(value)
+	}
+}
+
+// WithoutReason clears the Reason field.
+func WithoutReason() DetailOption {
+	return func(m *Detail) {
+		m.ClearReason()
+	}
+}
+
+// EventOption defines a functional option for Event.
+type EventOption = options.Option[*Event]
+
+// NewEvent creates a new Event.
+func NewEvent(opts ...EventOption) *Event {
+	m := &Event{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// ApplyEventOptions applies the provided options to an existing Event.
+func ApplyEventOptions(m *Event, opts ...EventOption) *Event {
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CloneEventWith returns a copy of m with the provided options applied, m itself is not modified.
+func CloneEventWith(m *Event, opts ...EventOption) *Event {
+	c := proto.Clone(m).(*Event)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithName sets the Name field.
+func WithName(value string) EventOption {
+	return func(m *Event) {
+		m.SetName(value)
+	}
+}
+
+// WithoutName clears the Name field.
+func WithoutName() EventOption {
+	return func(m *Event) {
+		m.ClearName()
+	}
+}
+
+// WithAttempts sets the Attempts field.
+func WithAttempts(value int32) EventOption {
+	return func(m *Event) {
+		m.SetAttempts(value)
+	}
+}
+
+// WithoutAttempts clears the Attempts field.
+func WithoutAttempts() EventOption {
+	return func(m *Event) {
+		m.SetAttempts(0)
+	}
+}
+
+// WithLevel sets the Level field.
+func WithLevel(value Level) EventOption {
+	return func(m *Event) {
+		m.SetLevel(value)
+	}
+}
+
+// WithLevelDebug sets the Level field to LEVEL_DEBUG.
+func WithLevelDebug() EventOption {
+	return func(m *Event) {
+		m.SetLevel(Level_LEVEL_DEBUG)
+	}
+}
+
+// WithLevelError sets the Level field to LEVEL_ERROR.
+func WithLevelError() EventOption {
+	return func(m *Event) {
+		m.SetLevel(Level_LEVEL_ERROR)
+	}
+}
+
+// WithoutLevel clears the Level field.
+func WithoutLevel() EventOption {
+	return func(m *Event) {
+		m.ClearLevel()
+	}
+}
+
+// WithTags sets the Tags field.
+func WithTags(values ...string) EventOption {
+	return func(m *Event) {
+		m.SetTags(values)
+	}
+}
+
+// AddTags appends the values to the Tags field.
+func AddTags(values ...string) EventOption {
+	return func(m *Event) {
+		m.SetTags(append(m.GetTags(), values...))
+	}
+}
+
+// WithoutTags clears the Tags field.
+func WithoutTags() EventOption {
+	return func(m *Event) {
+		m.SetTags(nil)
+	}
+}
+
+// WithCounters sets the Counters field.
+func WithCounters(value map[string]int32) EventOption {
+	return func(m *Event) {
+		m.SetCounters(value)
+	}
+}
+
+// PutCounters sets the entry for key in the Counters field.
+func PutCounters(key string, value int32) EventOption {
+	return func(m *Event) {
+		if m.GetCounters() == nil {
+			m.SetCounters(make(map[string]int32))
+		}
+		m.GetCounters()[key] = value
+	}
+}
+
+// MergeCounters copies the entries of value into the Counters field, overwriting existing keys.
+func MergeCounters(value map[string]int32) EventOption {
+	return func(m *Event) {
+		if m.GetCounters() == nil {
+			m.SetCounters(make(map[string]int32, len(value)))
+		}
+		for k, v := range value {
+			m.GetCounters()[k] = v
+		}
+	}
+}
+
+// WithoutCounters clears the Counters field.
+func WithoutCounters() EventOption {
+	return func(m *Event) {
+		m.SetCounters(nil)
+	}
+}
+
+// GetDetailAsJSON returns the Detail field as a JSON byte slice encoded with protojson.
+func (m *Event) GetDetailAsJSON() ([]byte, error) {
+	out, err := protojson.MarshalOptions{}.Marshal(m.GetDetail())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Detail field: %w", err)
+	}
+	return out, nil
+}
+
+// SetDetailFromJSON sets the Detail field from a JSON byte slice decoded with protojson.
+func (m *Event) SetDetailFromJSON(v []byte) error {
+	value := &Detail{}
+	err := protojson.UnmarshalOptions{}.Unmarshal(v, value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal Detail field: %w", err)
+	}
+	m.SetDetail(value)
+	return nil
+}
+
+// GetDetailAsBinary returns the Detail field encoded in the protobuf binary format.
+func (m *Event) GetDetailAsBinary() ([]byte, error) {
+	out, err := proto.Marshal(m.GetDetail())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Detail field: %w", err)
+	}
+	return out, nil
+}
+
+// SetDetailFromBinary sets the Detail field from the protobuf binary format.
+func (m *Event) SetDetailFromBinary(v []byte) error {
+	value := &Detail{}
+	if err := proto.Unmarshal(v, value); err != nil {
+		return fmt.Errorf("failed to unmarshal Detail field: %w", err)
+	}
+	m.SetDetail(value)
+	return nil
+}
+
+// WithNewDetailForEvent sets the Detail field with a new instance.
+func WithNewDetailForEvent(opts ...DetailOption) EventOption {
+	return func(m *Event) {
+		m.SetDetail(NewDetail(opts...))
+	}
+}
+
+// WithDetail sets the Detail field directly.
+func WithDetail(value *Detail) EventOption {
+	return func(m *Event) {
+		m.SetDetail(value)
+	}
+}
+
+// WithoutDetail clears the Detail field.
+func WithoutDetail() EventOption {
+	return func(m *Event) {
+		m.ClearDetail()
+	}
+}
+
+// WithNewSeenAtForEvent sets the SeenAt field with a new instance.
+func WithNewSeenAtForEvent(v time.Time) EventOption {
+	return func(m *Event) {
+		m.SetSeenAt(timestamppb.New(v))
+	}
+}
+
+// WithSeenAt sets the SeenAt field directly.
+func WithSeenAt(value *timestamppb.Timestamp) EventOption {
+	return func(m *Event) {
+		m.SetSeenAt(value)
+	}
+}
+
+// WithoutSeenAt clears the SeenAt field.
+func WithoutSeenAt() EventOption {
+	return func(m *Event) {
+		m.ClearSeenAt()
+	}
+}
+
+// WithText sets the Payload oneof field to Text.
+func WithText(value string) EventOption {
+	return func(m *Event) {
+		m.SetText(value)
+	}
+}
+
+// WithStructured sets the Payload oneof field to Structured.
+func WithStructured(value *Detail) EventOption {
+	return func(m *Event) {
+		m.SetStructured(value)
+	}
+}
+
+// WithNewStructuredForEvent sets the Structured field with a new instance.
+func WithNewStructuredForEvent(opts ...DetailOption) EventOption {
+	return func(m *Event) {
+		m.SetStructured(NewDetail(opts...))
+	}
+}
+
+// WithoutPayload clears the Payload oneof field.
+func WithoutPayload() EventOption {
+	return func(m *Event) {
+		m.ClearPayload()
+	}
+}
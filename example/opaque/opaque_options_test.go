@@ -0,0 +1,145 @@
+package opaque
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestEvent(t *testing.T) {
+	seenAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		opts []EventOption
+		want *Event
+	}{
+		{
+			name: "Scalars",
+			opts: []EventOption{
+				WithName("deploy"),
+				WithAttempts(2),
+				WithLevelError(),
+			},
+			want: Event_builder{
+				Name:     proto.String("deploy"),
+				Attempts: 2,
+				Level:    Level_LEVEL_ERROR.Enum(),
+			}.Build(),
+		},
+		{
+			name: "RepeatedAndMap",
+			opts: []EventOption{
+				WithTags("a", "b"),
+				WithCounters(map[string]int32{"retries": 1}),
+			},
+			want: Event_builder{
+				Tags:     []string{"a", "b"},
+				Counters: map[string]int32{"retries": 1},
+			}.Build(),
+		},
+		{
+			name: "AddPutAndMerge",
+			opts: []EventOption{
+				AddTags("a"),
+				AddTags("b"),
+				PutCounters("retries", 1),
+				MergeCounters(map[string]int32{"errors": 2}),
+			},
+			want: Event_builder{
+				Tags:     []string{"a", "b"},
+				Counters: map[string]int32{"retries": 1, "errors": 2},
+			}.Build(),
+		},
+		{
+			name: "Messages",
+			opts: []EventOption{
+				WithNewDetailForEvent(WithReason("timeout")),
+				WithNewSeenAtForEvent(seenAt),
+			},
+			want: Event_builder{
+				Detail: Detail_builder{Reason: proto.String("timeout")}.Build(),
+				SeenAt: timestamppb.New(seenAt),
+			}.Build(),
+		},
+		{
+			name: "Without",
+			opts: []EventOption{
+				WithName("deploy"),
+				WithAttempts(2),
+				AddTags("a"),
+				WithText("text"),
+				WithoutName(),
+				WithoutAttempts(),
+				WithoutTags(),
+				WithoutPayload(),
+			},
+			want: &Event{},
+		},
+		{
+			name: "Oneof",
+			opts: []EventOption{
+				WithText("first"),
+				WithNewStructuredForEvent(WithReason("second")),
+			},
+			want: Event_builder{
+				Structured: Detail_builder{Reason: proto.String("second")}.Build(),
+			}.Build(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewEvent(tt.opts...)
+			if diff := cmp.Diff(got, tt.want, cmp.Comparer(proto.Equal)); diff != "" {
+				t.Errorf("%s (-want +got):\n%s", tt.name, diff)
+			}
+
+			existing := &Event{}
+			ApplyEventOptions(existing, tt.opts...)
+			if diff := cmp.Diff(existing, tt.want, cmp.Comparer(proto.Equal)); diff != "" {
+				t.Errorf("ApplyEventOptions %s (-want +got):\n%s", tt.name, diff)
+			}
+		})
+	}
+}
+
+func TestEventPersistence(t *testing.T) {
+	event := NewEvent(WithNewDetailForEvent(WithReason("timeout")))
+
+	b, err := event.GetDetailAsBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := &Event{}
+	if err := got.SetDetailFromBinary(b); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(got, event, cmp.Comparer(proto.Equal)); diff != "" {
+		t.Errorf("binary round trip (-want +got):\n%s", diff)
+	}
+}
+
+func TestEventJSONPersistence(t *testing.T) {
+	event := NewEvent(WithNewDetailForEvent(WithReason("timeout")))
+
+	// Opaque messages are always encoded with protojson, encoding/json only sees their internal state
+	b, err := event.GetDetailAsJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"reason"`) || !strings.Contains(string(b), `"timeout"`) {
+		t.Errorf("GetDetailAsJSON got %s, want the reason field", b)
+	}
+	got := &Event{}
+	if err := got.SetDetailFromJSON(b); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(got, event, cmp.Comparer(proto.Equal)); diff != "" {
+		t.Errorf("JSON round trip (-want +got):\n%s", diff)
+	}
+}
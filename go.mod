@@ -4,5 +4,5 @@ go 1.23.4
 
 require (
 	github.com/google/go-cmp v0.5.5
-	google.golang.org/protobuf v1.36.1
+	google.golang.org/protobuf v1.36.3
 )
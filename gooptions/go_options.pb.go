@@ -0,0 +1,706 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.3
+// 	protoc        v5.29.2
+// source: gooptions/go_options.proto
+
+package gooptions
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SqlEncoding selects how the database/sql wrapper of a field stores the message.
+type SqlEncoding int32
+
+const (
+	SqlEncoding_SQL_ENCODING_UNSPECIFIED SqlEncoding = 0
+	// protojson, honouring the json options of the field, e.g. for jsonb columns.
+	SqlEncoding_SQL_ENCODING_JSON SqlEncoding = 1
+	// The protobuf binary format, e.g. for bytea columns.
+	SqlEncoding_SQL_ENCODING_BINARY SqlEncoding = 2
+)
+
+// Enum value maps for SqlEncoding.
+var (
+	SqlEncoding_name = map[int32]string{
+		0: "SQL_ENCODING_UNSPECIFIED",
+		1: "SQL_ENCODING_JSON",
+		2: "SQL_ENCODING_BINARY",
+	}
+	SqlEncoding_value = map[string]int32{
+		"SQL_ENCODING_UNSPECIFIED": 0,
+		"SQL_ENCODING_JSON":        1,
+		"SQL_ENCODING_BINARY":      2,
+	}
+)
+
+func (x SqlEncoding) Enum() *SqlEncoding {
+	p := new(SqlEncoding)
+	*p = x
+	return p
+}
+
+func (x SqlEncoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SqlEncoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_gooptions_go_options_proto_enumTypes[0].Descriptor()
+}
+
+func (SqlEncoding) Type() protoreflect.EnumType {
+	return &file_gooptions_go_options_proto_enumTypes[0]
+}
+
+func (x SqlEncoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SqlEncoding.Descriptor instead.
+func (SqlEncoding) EnumDescriptor() ([]byte, []int) {
+	return file_gooptions_go_options_proto_rawDescGZIP(), []int{0}
+}
+
+// FileOptions apply to every message declared in the file.
+// Values set on a message through MessageOptions take precedence.
+//
+//	option (go_options.file).skip_init = true;
+type FileOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Do not generate the Apply function for any message in this file.
+	Optionless *bool `protobuf:"varint,1,opt,name=optionless" json:"optionless,omitempty"`
+	// Do not generate the New constructor for any message in this file.
+	SkipInit *bool `protobuf:"varint,2,opt,name=skip_init,json=skipInit" json:"skip_init,omitempty"`
+	// Defaults for the JSON persistence methods of every field in this file.
+	Json *JsonOptions `protobuf:"bytes,3,opt,name=json" json:"json,omitempty"`
+	// Generate error returning options for every message in this file.
+	ErrorOptions *bool `protobuf:"varint,4,opt,name=error_options,json=errorOptions" json:"error_options,omitempty"`
+	// Generate a fluent builder for every message in this file.
+	Builders      *bool `protobuf:"varint,5,opt,name=builders" json:"builders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileOptions) Reset() {
+	*x = FileOptions{}
+	mi := &file_gooptions_go_options_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileOptions) ProtoMessage() {}
+
+func (x *FileOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_gooptions_go_options_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileOptions.ProtoReflect.Descriptor instead.
+func (*FileOptions) Descriptor() ([]byte, []int) {
+	return file_gooptions_go_options_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FileOptions) GetOptionless() bool {
+	if x != nil && x.Optionless != nil {
+		return *x.Optionless
+	}
+	return false
+}
+
+func (x *FileOptions) GetSkipInit() bool {
+	if x != nil && x.SkipInit != nil {
+		return *x.SkipInit
+	}
+	return false
+}
+
+func (x *FileOptions) GetJson() *JsonOptions {
+	if x != nil {
+		return x.Json
+	}
+	return nil
+}
+
+func (x *FileOptions) GetErrorOptions() bool {
+	if x != nil && x.ErrorOptions != nil {
+		return *x.ErrorOptions
+	}
+	return false
+}
+
+func (x *FileOptions) GetBuilders() bool {
+	if x != nil && x.Builders != nil {
+		return *x.Builders
+	}
+	return false
+}
+
+// MessageOptions customise the code generated for a single message.
+//
+//	message Foo {
+//	  option (go_options.message).skip_init = true;
+//	}
+type MessageOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Do not generate the Apply function for this message, messages
+	// referring to it get a WithNew option without arguments.
+	Optionless *bool `protobuf:"varint,1,opt,name=optionless" json:"optionless,omitempty"`
+	// Do not generate the New constructor for this message.
+	// This is useful if you already have an existing New function.
+	SkipInit *bool `protobuf:"varint,2,opt,name=skip_init,json=skipInit" json:"skip_init,omitempty"`
+	// Generate the <Message>OptionE type, NewE constructor and validating E options
+	// for this message, next to the regular options.
+	ErrorOptions *bool `protobuf:"varint,3,opt,name=error_options,json=errorOptions" json:"error_options,omitempty"`
+	// Generate the <Message>Builder type with a method mirroring each option.
+	Builders      *bool `protobuf:"varint,4,opt,name=builders" json:"builders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageOptions) Reset() {
+	*x = MessageOptions{}
+	mi := &file_gooptions_go_options_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageOptions) ProtoMessage() {}
+
+func (x *MessageOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_gooptions_go_options_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageOptions.ProtoReflect.Descriptor instead.
+func (*MessageOptions) Descriptor() ([]byte, []int) {
+	return file_gooptions_go_options_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MessageOptions) GetOptionless() bool {
+	if x != nil && x.Optionless != nil {
+		return *x.Optionless
+	}
+	return false
+}
+
+func (x *MessageOptions) GetSkipInit() bool {
+	if x != nil && x.SkipInit != nil {
+		return *x.SkipInit
+	}
+	return false
+}
+
+func (x *MessageOptions) GetErrorOptions() bool {
+	if x != nil && x.ErrorOptions != nil {
+		return *x.ErrorOptions
+	}
+	return false
+}
+
+func (x *MessageOptions) GetBuilders() bool {
+	if x != nil && x.Builders != nil {
+		return *x.Builders
+	}
+	return false
+}
+
+// FieldOptions customise the code generated for a single field.
+//
+//	BasicMessage basic = 1 [(go_options.field).json_persistent = true];
+type FieldOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Generate GetFieldAsJSON and SetFieldFromJSON methods for the field.
+	JsonPersistent *bool `protobuf:"varint,1,opt,name=json_persistent,json=jsonPersistent" json:"json_persistent,omitempty"`
+	// Do not generate any option for the field.
+	Skip *bool `protobuf:"varint,2,opt,name=skip" json:"skip,omitempty"`
+	// Configure the JSON persistence methods, overrides the file and plugin defaults.
+	Json *JsonOptions `protobuf:"bytes,3,opt,name=json" json:"json,omitempty"`
+	// Generate GetFieldAsBinary and SetFieldFromBinary methods using proto.Marshal.
+	BinaryPersistent *bool `protobuf:"varint,4,opt,name=binary_persistent,json=binaryPersistent" json:"binary_persistent,omitempty"`
+	// Generate GetFieldAsText and SetFieldFromText methods using prototext.
+	TextPersistent *bool `protobuf:"varint,5,opt,name=text_persistent,json=textPersistent" json:"text_persistent,omitempty"`
+	// Generate GetFieldAsBase64 and SetFieldFromBase64 methods encoding the binary format in base64.
+	Base64Persistent *bool `protobuf:"varint,6,opt,name=base64_persistent,json=base64Persistent" json:"base64_persistent,omitempty"`
+	// Generate a <Message><Field>SQL type implementing sql.Scanner and driver.Valuer for a message field.
+	Sql *SqlEncoding `protobuf:"varint,7,opt,name=sql,enum=go_options.SqlEncoding" json:"sql,omitempty"`
+	// Name the options of the field use instead of the Go field name, e.g. "UserId" for WithUserId and WithoutUserId.
+	// The name is never qualified with For<Message>, so it stays the same when fields are added to the package.
+	Name          *string `protobuf:"bytes,8,opt,name=name" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldOptions) Reset() {
+	*x = FieldOptions{}
+	mi := &file_gooptions_go_options_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldOptions) ProtoMessage() {}
+
+func (x *FieldOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_gooptions_go_options_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldOptions.ProtoReflect.Descriptor instead.
+func (*FieldOptions) Descriptor() ([]byte, []int) {
+	return file_gooptions_go_options_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FieldOptions) GetJsonPersistent() bool {
+	if x != nil && x.JsonPersistent != nil {
+		return *x.JsonPersistent
+	}
+	return false
+}
+
+func (x *FieldOptions) GetSkip() bool {
+	if x != nil && x.Skip != nil {
+		return *x.Skip
+	}
+	return false
+}
+
+func (x *FieldOptions) GetJson() *JsonOptions {
+	if x != nil {
+		return x.Json
+	}
+	return nil
+}
+
+func (x *FieldOptions) GetBinaryPersistent() bool {
+	if x != nil && x.BinaryPersistent != nil {
+		return *x.BinaryPersistent
+	}
+	return false
+}
+
+func (x *FieldOptions) GetTextPersistent() bool {
+	if x != nil && x.TextPersistent != nil {
+		return *x.TextPersistent
+	}
+	return false
+}
+
+func (x *FieldOptions) GetBase64Persistent() bool {
+	if x != nil && x.Base64Persistent != nil {
+		return *x.Base64Persistent
+	}
+	return false
+}
+
+func (x *FieldOptions) GetSql() SqlEncoding {
+	if x != nil && x.Sql != nil {
+		return *x.Sql
+	}
+	return SqlEncoding_SQL_ENCODING_UNSPECIFIED
+}
+
+func (x *FieldOptions) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// JsonOptions configure the encoding used by the JSON persistence methods.
+// Unset values fall back to the file options and then the plugin parameters.
+//
+//	BasicMessage basic = 1 [(go_options.field) = {
+//	  json_persistent: true
+//	  json: { protojson: true, use_proto_names: true }
+//	}];
+type JsonOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Use protojson instead of encoding/json, this respects oneofs, json_name and well-known types.
+	Protojson *bool `protobuf:"varint,1,opt,name=protojson" json:"protojson,omitempty"`
+	// protojson.MarshalOptions.UseProtoNames
+	UseProtoNames *bool `protobuf:"varint,2,opt,name=use_proto_names,json=useProtoNames" json:"use_proto_names,omitempty"`
+	// protojson.MarshalOptions.EmitUnpopulated
+	EmitUnpopulated *bool `protobuf:"varint,3,opt,name=emit_unpopulated,json=emitUnpopulated" json:"emit_unpopulated,omitempty"`
+	// protojson.UnmarshalOptions.DiscardUnknown
+	DiscardUnknown *bool `protobuf:"varint,4,opt,name=discard_unknown,json=discardUnknown" json:"discard_unknown,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *JsonOptions) Reset() {
+	*x = JsonOptions{}
+	mi := &file_gooptions_go_options_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JsonOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JsonOptions) ProtoMessage() {}
+
+func (x *JsonOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_gooptions_go_options_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JsonOptions.ProtoReflect.Descriptor instead.
+func (*JsonOptions) Descriptor() ([]byte, []int) {
+	return file_gooptions_go_options_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *JsonOptions) GetProtojson() bool {
+	if x != nil && x.Protojson != nil {
+		return *x.Protojson
+	}
+	return false
+}
+
+func (x *JsonOptions) GetUseProtoNames() bool {
+	if x != nil && x.UseProtoNames != nil {
+		return *x.UseProtoNames
+	}
+	return false
+}
+
+func (x *JsonOptions) GetEmitUnpopulated() bool {
+	if x != nil && x.EmitUnpopulated != nil {
+		return *x.EmitUnpopulated
+	}
+	return false
+}
+
+func (x *JsonOptions) GetDiscardUnknown() bool {
+	if x != nil && x.DiscardUnknown != nil {
+		return *x.DiscardUnknown
+	}
+	return false
+}
+
+// OneofOptions customise the code generated for a oneof group.
+//
+//	oneof choice {
+//	  option (go_options.oneof).skip = true;
+//	}
+type OneofOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Do not generate any option for the members of the oneof.
+	Skip          *bool `protobuf:"varint,1,opt,name=skip" json:"skip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OneofOptions) Reset() {
+	*x = OneofOptions{}
+	mi := &file_gooptions_go_options_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OneofOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OneofOptions) ProtoMessage() {}
+
+func (x *OneofOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_gooptions_go_options_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OneofOptions.ProtoReflect.Descriptor instead.
+func (*OneofOptions) Descriptor() ([]byte, []int) {
+	return file_gooptions_go_options_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OneofOptions) GetSkip() bool {
+	if x != nil && x.Skip != nil {
+		return *x.Skip
+	}
+	return false
+}
+
+var file_gooptions_go_options_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*FileOptions)(nil),
+		Field:         51300,
+		Name:          "go_options.file",
+		Tag:           "bytes,51300,opt,name=file",
+		Filename:      "gooptions/go_options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*MessageOptions)(nil),
+		Field:         51300,
+		Name:          "go_options.message",
+		Tag:           "bytes,51300,opt,name=message",
+		Filename:      "gooptions/go_options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*FieldOptions)(nil),
+		Field:         51300,
+		Name:          "go_options.field",
+		Tag:           "bytes,51300,opt,name=field",
+		Filename:      "gooptions/go_options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.OneofOptions)(nil),
+		ExtensionType: (*OneofOptions)(nil),
+		Field:         51300,
+		Name:          "go_options.oneof",
+		Tag:           "bytes,51300,opt,name=oneof",
+		Filename:      "gooptions/go_options.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FileOptions.
+var (
+	// optional go_options.FileOptions file = 51300;
+	E_File = &file_gooptions_go_options_proto_extTypes[0]
+)
+
+// Extension fields to descriptorpb.MessageOptions.
+var (
+	// optional go_options.MessageOptions message = 51300;
+	E_Message = &file_gooptions_go_options_proto_extTypes[1]
+)
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// optional go_options.FieldOptions field = 51300;
+	E_Field = &file_gooptions_go_options_proto_extTypes[2]
+)
+
+// Extension fields to descriptorpb.OneofOptions.
+var (
+	// optional go_options.OneofOptions oneof = 51300;
+	E_Oneof = &file_gooptions_go_options_proto_extTypes[3]
+)
+
+var File_gooptions_go_options_proto protoreflect.FileDescriptor
+
+var file_gooptions_go_options_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x67, 0x6f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x6f, 0x5f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x67, 0x6f,
+	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb8, 0x01, 0x0a, 0x0b, 0x46,
+	0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6b,
+	0x69, 0x70, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73,
+	0x6b, 0x69, 0x70, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04,
+	0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x65, 0x72, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6b, 0x69, 0x70,
+	0x5f, 0x69, 0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x6b, 0x69,
+	0x70, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x22, 0xba, 0x02, 0x0a, 0x0c, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f,
+	0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x73, 0x6b, 0x69, 0x70, 0x12, 0x2b, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x4a, 0x73, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x6a, 0x73, 0x6f,
+	0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x62, 0x69,
+	0x6e, 0x61, 0x72, 0x79, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x74, 0x65, 0x78, 0x74, 0x50, 0x65, 0x72,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x62, 0x61, 0x73, 0x65, 0x36,
+	0x34, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x53,
+	0x71, 0x6c, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x0b, 0x4a, 0x73, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6d, 0x69,
+	0x74, 0x5f, 0x75, 0x6e, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x6d, 0x69, 0x74, 0x55, 0x6e, 0x70, 0x6f, 0x70, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x5f,
+	0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64,
+	0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x22, 0x22, 0x0a,
+	0x0c, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x6b, 0x69,
+	0x70, 0x2a, 0x5b, 0x0a, 0x0b, 0x53, 0x71, 0x6c, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x1c, 0x0a, 0x18, 0x53, 0x51, 0x4c, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15,
+	0x0a, 0x11, 0x53, 0x51, 0x4c, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4a,
+	0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x51, 0x4c, 0x5f, 0x45, 0x4e, 0x43,
+	0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x02, 0x3a, 0x4b,
+	0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xe4, 0x90, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x3a, 0x57, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xe4, 0x90, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x3a, 0x4f, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xe4, 0x90, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x4f, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x1d,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xe4, 0x90,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x72, 0x77, 0x65, 0x79, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x67, 0x6f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3b, 0x67, 0x6f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x62, 0x08, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x70,
+	0xe8, 0x07,
+}
+
+var (
+	file_gooptions_go_options_proto_rawDescOnce sync.Once
+	file_gooptions_go_options_proto_rawDescData = file_gooptions_go_options_proto_rawDesc
+)
+
+func file_gooptions_go_options_proto_rawDescGZIP() []byte {
+	file_gooptions_go_options_proto_rawDescOnce.Do(func() {
+		file_gooptions_go_options_proto_rawDescData = protoimpl.X.CompressGZIP(file_gooptions_go_options_proto_rawDescData)
+	})
+	return file_gooptions_go_options_proto_rawDescData
+}
+
+var file_gooptions_go_options_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_gooptions_go_options_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_gooptions_go_options_proto_goTypes = []any{
+	(SqlEncoding)(0),                    // 0: go_options.SqlEncoding
+	(*FileOptions)(nil),                 // 1: go_options.FileOptions
+	(*MessageOptions)(nil),              // 2: go_options.MessageOptions
+	(*FieldOptions)(nil),                // 3: go_options.FieldOptions
+	(*JsonOptions)(nil),                 // 4: go_options.JsonOptions
+	(*OneofOptions)(nil),                // 5: go_options.OneofOptions
+	(*descriptorpb.FileOptions)(nil),    // 6: google.protobuf.FileOptions
+	(*descriptorpb.MessageOptions)(nil), // 7: google.protobuf.MessageOptions
+	(*descriptorpb.FieldOptions)(nil),   // 8: google.protobuf.FieldOptions
+	(*descriptorpb.OneofOptions)(nil),   // 9: google.protobuf.OneofOptions
+}
+var file_gooptions_go_options_proto_depIdxs = []int32{
+	4,  // 0: go_options.FileOptions.json:type_name -> go_options.JsonOptions
+	4,  // 1: go_options.FieldOptions.json:type_name -> go_options.JsonOptions
+	0,  // 2: go_options.FieldOptions.sql:type_name -> go_options.SqlEncoding
+	6,  // 3: go_options.file:extendee -> google.protobuf.FileOptions
+	7,  // 4: go_options.message:extendee -> google.protobuf.MessageOptions
+	8,  // 5: go_options.field:extendee -> google.protobuf.FieldOptions
+	9,  // 6: go_options.oneof:extendee -> google.protobuf.OneofOptions
+	1,  // 7: go_options.file:type_name -> go_options.FileOptions
+	2,  // 8: go_options.message:type_name -> go_options.MessageOptions
+	3,  // 9: go_options.field:type_name -> go_options.FieldOptions
+	5,  // 10: go_options.oneof:type_name -> go_options.OneofOptions
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	7,  // [7:11] is the sub-list for extension type_name
+	3,  // [3:7] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_gooptions_go_options_proto_init() }
+func file_gooptions_go_options_proto_init() {
+	if File_gooptions_go_options_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gooptions_go_options_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 4,
+			NumServices:   0,
+		},
+		GoTypes:           file_gooptions_go_options_proto_goTypes,
+		DependencyIndexes: file_gooptions_go_options_proto_depIdxs,
+		EnumInfos:         file_gooptions_go_options_proto_enumTypes,
+		MessageInfos:      file_gooptions_go_options_proto_msgTypes,
+		ExtensionInfos:    file_gooptions_go_options_proto_extTypes,
+	}.Build()
+	File_gooptions_go_options_proto = out.File
+	file_gooptions_go_options_proto_rawDesc = nil
+	file_gooptions_go_options_proto_goTypes = nil
+	file_gooptions_go_options_proto_depIdxs = nil
+}
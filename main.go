@@ -1,205 +1,299 @@
 package main
 
 import (
+	"errors"
+	"flag"
 	"fmt"
+	"go/token"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"unicode"
 
+	"github.com/terwey/protoc-gen-go-options/gooptions"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/gofeaturespb"
 	"google.golang.org/protobuf/types/pluginpb"
 )
 
-var logEnabled = false
+// flags holds the plugin parameters, passed as --go-options_opt=name=value.
+var flags flag.FlagSet
 
+var (
+	logEnabled        = flags.Bool("debug", false, "emit debug comments in the generated code")
+	fileSuffix        = flags.String("suffix", "_options.go", "suffix of the generated files")
+	optionPrefix      = flags.String("option_prefix", "With", "prefix of the generated option functions")
+	constructorPrefix = flags.String("constructor_prefix", "New", "prefix of the generated constructors")
+	disableJson       = flags.Bool("disable_json", false, "do not generate the JSON persistence methods")
+	disableWkt        = flags.Bool("disable_wkt", false, "do not generate conveniences for well-known types")
+	errorOptions      = flags.Bool("error_options", false, "generate error returning options for every message")
+	builders          = flags.Bool("builders", false, "generate a fluent builder for every message")
+	disableRuntime    = flags.Bool("disable_runtime", false, "declare the option types as plain func types instead of aliases of the options runtime package")
+	validateFunc      = flags.String("validate_func", "", "func(proto.Message) error run by the Validated constructors, e.g. buf.build/go/protovalidate.Validate")
+	qualifiedNames    = flags.Bool("qualified_names", false, "always append For<Message> to the option names of fields, oneofs and defaults")
+	namesLock         = flags.String("names_lock", "", "file remembering the issued option names, relative to the output directory, which must be the working directory of protoc")
+
+	// defaults for the JSON persistence methods, overridden by the json custom options of the file and field
+	useProtojson        = flags.Bool("protojson", false, "use protojson instead of encoding/json for the JSON persistence methods")
+	jsonUseProtoNames   = flags.Bool("json_use_proto_names", false, "set protojson.MarshalOptions.UseProtoNames")
+	jsonEmitUnpopulated = flags.Bool("json_emit_unpopulated", false, "set protojson.MarshalOptions.EmitUnpopulated")
+	jsonDiscardUnknown  = flags.Bool("json_discard_unknown", false, "set protojson.UnmarshalOptions.DiscardUnknown")
+)
+
+const (
+	protoPackage        = protogen.GoImportPath("google.golang.org/protobuf/proto")
+	fmtPackage          = protogen.GoImportPath("fmt")
+	jsonPackage         = protogen.GoImportPath("encoding/json")
+	timePackage         = protogen.GoImportPath("time")
+	protojsonPackage    = protogen.GoImportPath("google.golang.org/protobuf/encoding/protojson")
+	prototextPackage    = protogen.GoImportPath("google.golang.org/protobuf/encoding/prototext")
+	base64Package       = protogen.GoImportPath("encoding/base64")
+	driverPackage       = protogen.GoImportPath("database/sql/driver")
+	protoreflectPackage = protogen.GoImportPath("google.golang.org/protobuf/reflect/protoreflect")
+	stringsPackage      = protogen.GoImportPath("strings")
+	mathPackage         = protogen.GoImportPath("math")
+	bigPackage          = protogen.GoImportPath("math/big")
+	optionsPackage      = protogen.GoImportPath("github.com/terwey/protoc-gen-go-options/options")
+	utf8Package         = protogen.GoImportPath("unicode/utf8")
+)
+
+// OptionFlag names a boolean setting of the go_options.proto custom options.
+// The field in the custom option message is the lowercase flag without the GO_OPTIONS_ prefix,
+// GO_OPTIONS_SKIP_INIT is read from (go_options.message).skip_init.
 type OptionFlag string
 
 const (
-	GO_OPTIONS_OPTIONLESS      OptionFlag = "GO_OPTIONS_OPTIONLESS"
-	GO_OPTIONS_SKIP_INIT       OptionFlag = "GO_OPTIONS_SKIP_INIT"
-	GO_OPTIONS_JSON_PERSISTENT OptionFlag = "GO_OPTIONS_JSON_PERSISTENT"
+	GO_OPTIONS_OPTIONLESS        OptionFlag = "GO_OPTIONS_OPTIONLESS"
+	GO_OPTIONS_SKIP_INIT         OptionFlag = "GO_OPTIONS_SKIP_INIT"
+	GO_OPTIONS_JSON_PERSISTENT   OptionFlag = "GO_OPTIONS_JSON_PERSISTENT"
+	GO_OPTIONS_SKIP              OptionFlag = "GO_OPTIONS_SKIP"
+	GO_OPTIONS_BINARY_PERSISTENT OptionFlag = "GO_OPTIONS_BINARY_PERSISTENT"
+	GO_OPTIONS_TEXT_PERSISTENT   OptionFlag = "GO_OPTIONS_TEXT_PERSISTENT"
+	GO_OPTIONS_BASE64_PERSISTENT OptionFlag = "GO_OPTIONS_BASE64_PERSISTENT"
+	GO_OPTIONS_ERROR_OPTIONS     OptionFlag = "GO_OPTIONS_ERROR_OPTIONS"
+	GO_OPTIONS_BUILDERS          OptionFlag = "GO_OPTIONS_BUILDERS"
 )
 
+// commentFlags are the flags that can also be enabled by writing them in the leading comment.
+// Deprecated: comment markers are kept for backwards compatibility, use the custom options instead.
+var commentFlags = map[OptionFlag]bool{
+	GO_OPTIONS_OPTIONLESS:        true,
+	GO_OPTIONS_SKIP_INIT:         true,
+	GO_OPTIONS_JSON_PERSISTENT:   true,
+	GO_OPTIONS_BINARY_PERSISTENT: true,
+	GO_OPTIONS_TEXT_PERSISTENT:   true,
+	GO_OPTIONS_BASE64_PERSISTENT: true,
+}
+
+// fieldName returns the name of the field in the custom option messages holding this flag.
+func (o OptionFlag) fieldName() protoreflect.Name {
+	return protoreflect.Name(strings.ToLower(strings.TrimPrefix(string(o), "GO_OPTIONS_")))
+}
+
 func main() {
 	opts := protogen.Options{
-		ParamFunc: func(name, value string) error {
-			// Handle any custom plugin parameters here, if needed.
-			return nil
-		},
-	}
-
-	opts.Run(func(gen *protogen.Plugin) error {
-		// Declare support for editions
-		gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS)
-		// if you also want to do FEATURE_PROTO3_OPTIONAL you can do the following
-		// gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL | pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS)
-
-		// this is required to get it to work with editions, need a minimum and maximum edition
-		gen.SupportedEditionsMinimum = descriptorpb.Edition_EDITION_PROTO2
-		gen.SupportedEditionsMaximum = descriptorpb.Edition_EDITION_2023
-
-		// Build collision map across all files in the same package
-		packageCollisions := make(map[string]map[string]int)
-		for _, file := range gen.Files {
-			if !file.Generate {
-				continue
-			}
-			pkgName := string(file.GoPackageName)
-			if packageCollisions[pkgName] == nil {
-				packageCollisions[pkgName] = make(map[string]int)
-			}
-			for _, msg := range file.Messages {
-				for _, field := range msg.Fields {
-					packageCollisions[pkgName][field.GoName]++
-				}
-			}
-		}
+		ParamFunc: setParam,
+	}
 
-		for _, file := range gen.Files {
-			if !file.Generate {
-				continue
-			}
-			pkgName := string(file.GoPackageName)
-			generateFile(gen, file, packageCollisions[pkgName])
-		}
-		return nil
-	})
+	opts.Run(generate)
 }
 
-func getImports(file *protogen.File) []string {
-	var imports []string
-
-	if requiresJson(file) {
-		imports = append(imports, "\"encoding/json\"")
-	}
-	if requiresFmt(file) {
-		imports = append(imports, "\"fmt\"")
+// setParam applies a single plugin parameter, unknown parameters are rejected.
+func setParam(name, value string) error {
+	if flags.Lookup(name) == nil {
+		return fmt.Errorf("unknown parameter %q", name)
 	}
-	if requiresProto(file) {
-		imports = append(imports, "\"google.golang.org/protobuf/proto\"")
+	if err := flags.Set(name, value); err != nil {
+		return fmt.Errorf("invalid value %q for parameter %q: %w", value, name, err)
 	}
-	if requiresTime(file) {
-		imports = append(imports, "\"time\"")
+	return nil
+}
+
+// generate writes the options file for every file in the request that should be generated.
+func generate(gen *protogen.Plugin) error {
+	if err := validateFlags(); err != nil {
+		return err
 	}
 
-	return imports
-}
+	// Declare support for editions and proto3 optional fields, the setters follow the presence of each field
+	gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL | pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS)
 
-func requiresTime(file *protogen.File) bool {
-	for _, message := range file.Messages {
-		for _, field := range message.Fields {
-			if field.Desc.Kind() != protoreflect.MessageKind {
-				continue
-			}
-			if field.Message.GoIdent.GoName == "Timestamp" {
-				return true
-			}
-			if field.Message.GoIdent.GoName == "Duration" && wellKnownPath(field.Message.GoIdent) {
-				return true
-			}
-			if field.Message.GoIdent.GoName == "Date" && strings.Contains(field.Message.GoIdent.GoImportPath.String(), "googleapis/type/date") {
-				return true
+	// this is required to get it to work with editions, need a minimum and maximum edition
+	gen.SupportedEditionsMinimum = descriptorpb.Edition_EDITION_PROTO2
+	gen.SupportedEditionsMaximum = descriptorpb.Edition_EDITION_2023
+
+	for _, file := range gen.Files {
+		if !file.Generate {
+			continue
+		}
+		for _, msg := range allMessages(file.Messages) {
+			for _, field := range msg.Fields {
+				if err := validateSqlEncoding(field); err != nil {
+					return err
+				}
+				if err := validateFieldName(field); err != nil {
+					return err
+				}
+				if err := validateGoogleType(field); err != nil {
+					return err
+				}
 			}
 		}
 	}
-	return false
-}
 
-func requiresProto(file *protogen.File) bool {
-	for _, message := range file.Messages {
-		for _, field := range message.Fields {
-			if protoHelperFunc(field.Desc.Kind()) != "" {
-				return true
-			}
-		}
+	locked, err := readNamesLock()
+	if err != nil {
+		return err
+	}
+	names, err := resolveOptionNames(gen, locked)
+	if err != nil {
+		return err
 	}
-	return false
-}
 
-func requiresJson(file *protogen.File) bool {
-	for _, message := range file.Messages {
-		for _, field := range message.Fields {
-			if optionFlagForField(field, GO_OPTIONS_JSON_PERSISTENT) {
-				return true
-			}
+	for _, file := range gen.Files {
+		if !file.Generate {
+			continue
 		}
+		generateFile(gen, file, names)
 	}
-	return false
+	if *namesLock != "" {
+		generateNamesLock(gen, locked, names)
+	}
+	return nil
 }
 
-func requiresFmt(file *protogen.File) bool {
-	for _, message := range file.Messages {
-		for _, field := range message.Fields {
-			if optionFlagForField(field, GO_OPTIONS_JSON_PERSISTENT) {
-				return true
-			}
+// validateFlags checks the plugin parameters for values that would generate invalid code.
+func validateFlags() error {
+	if !strings.HasSuffix(*fileSuffix, ".go") {
+		return fmt.Errorf("parameter suffix must end in .go, got %q", *fileSuffix)
+	}
+	if !token.IsIdentifier(*optionPrefix) {
+		return fmt.Errorf("parameter option_prefix must be a valid Go identifier, got %q", *optionPrefix)
+	}
+	if !token.IsIdentifier(*constructorPrefix) {
+		return fmt.Errorf("parameter constructor_prefix must be a valid Go identifier, got %q", *constructorPrefix)
+	}
+	// the lock is read from the working directory and written to the output directory,
+	// a relative path inside both only finds the previous lock when protoc runs from the output root
+	if *namesLock != "" && !filepath.IsLocal(*namesLock) {
+		return fmt.Errorf("parameter names_lock must be a relative path without .., got %q", *namesLock)
+	}
+	if *validateFunc != "" {
+		if i := strings.LastIndex(*validateFunc, "."); i <= 0 || !token.IsIdentifier((*validateFunc)[i+1:]) {
+			return fmt.Errorf("parameter validate_func must be an import path followed by a function name, got %q", *validateFunc)
 		}
 	}
-	return false
+	return nil
 }
 
-func generateFile(gen *protogen.Plugin, file *protogen.File, packageCollisionMap map[string]int) {
-	filename := file.GeneratedFilenamePrefix + "_options.go"
+func generateFile(gen *protogen.Plugin, file *protogen.File, names optionNames) {
+	filename := file.GeneratedFilenamePrefix + *fileSuffix
 	g := gen.NewGeneratedFile(filename, file.GoImportPath)
 
 	g.P("// Code generated by protoc-gen-go-options. DO NOT EDIT.")
 	g.P("// source: ", file.Proto.GetName())
 	log(g, "log enabled")
 	g.P("package ", file.GoPackageName)
-	imports := getImports(file)
-	if len(imports) != 0 {
-		g.P("import (")
-		for _, i := range imports {
-			g.P(i)
-		}
-		g.P(")")
+
+	for _, message := range allMessages(file.Messages) {
+		generateOptionsForMessage(g, message, names)
 	}
+}
 
-	for _, message := range file.Messages {
-		generateOptionsForMessage(g, message, packageCollisionMap)
+// allMessages flattens the given messages and all messages declared inside them, depth first.
+// Synthetic map entry messages are skipped as they are never constructed directly.
+func allMessages(messages []*protogen.Message) []*protogen.Message {
+	var out []*protogen.Message
+	for _, message := range messages {
+		if message.Desc.IsMapEntry() {
+			continue
+		}
+		out = append(out, message)
+		out = append(out, allMessages(message.Messages)...)
 	}
+	return out
 }
 
 func log(g *protogen.GeneratedFile, v ...any) {
-	if logEnabled {
+	if *logEnabled {
 		v = append([]any{"// debug: "}, v...)
 		g.P(v...)
 	}
 }
 
-func detectCollisions(messages []*protogen.Message) map[string]int {
-	collisionMap := make(map[string]int)
-	for _, msg := range messages {
-		collisionMap[msg.GoIdent.GoName]++
-		for _, field := range msg.Fields {
-			collisionMap[field.GoName]++
-		}
+// optionFlagForMessage reports whether the flag is set for the message.
+// The (go_options.message) option takes precedence over the (go_options.file) option,
+// when neither sets the flag the deprecated comment marker is checked.
+func optionFlagForMessage(message *protogen.Message, o OptionFlag) bool {
+	if v, ok := extensionFlag(message.Desc.Options(), gooptions.E_Message, o); ok {
+		return v
+	}
+	if v, ok := extensionFlag(message.Desc.ParentFile().Options(), gooptions.E_File, o); ok {
+		return v
 	}
-	return collisionMap
+	return commentFlag(message.Comments, o)
 }
 
-func optionFlagForMessage(message *protogen.Message, o OptionFlag) bool {
-	return strings.Contains(message.Comments.Leading.String(), string(o))
+// optionFlagForField reports whether the flag is set through (go_options.field) or the deprecated comment marker.
+func optionFlagForField(field *protogen.Field, o OptionFlag) bool {
+	if v, ok := extensionFlag(field.Desc.Options(), gooptions.E_Field, o); ok {
+		return v
+	}
+	return commentFlag(field.Comments, o)
 }
 
-func optionFlagForField(field *protogen.Field, o OptionFlag) bool {
-	return strings.Contains(field.Comments.Leading.String(), string(o))
+// optionFlagForOneof reports whether the flag is set through (go_options.oneof).
+func optionFlagForOneof(oneof *protogen.Oneof, o OptionFlag) bool {
+	v, _ := extensionFlag(oneof.Desc.Options(), gooptions.E_Oneof, o)
+	return v
+}
+
+// extensionFlag reads the flag from the custom option extension xt in the descriptor options.
+// The second return value reports whether the flag was explicitly set.
+func extensionFlag(options proto.Message, xt protoreflect.ExtensionType, o OptionFlag) (bool, bool) {
+	if options == nil || !proto.HasExtension(options, xt) {
+		return false, false
+	}
+	m := proto.GetExtension(options, xt).(proto.Message).ProtoReflect()
+	fd := m.Descriptor().Fields().ByName(o.fieldName())
+	if fd == nil || !m.Has(fd) {
+		return false, false
+	}
+	return m.Get(fd).Bool(), true
+}
+
+// commentFlag checks the leading comment for the deprecated comment marker of the flag.
+func commentFlag(comments protogen.CommentSet, o OptionFlag) bool {
+	return commentFlags[o] && strings.Contains(comments.Leading.String(), string(o))
 }
 
-func generateOptionsForMessage(g *protogen.GeneratedFile, message *protogen.Message, collisionMap map[string]int) {
+func generateOptionsForMessage(g *protogen.GeneratedFile, message *protogen.Message, names optionNames) {
 	if message.Fields == nil {
 		log(g, "skipping message because it has no fields: ", message.GoIdent.GoName)
 		return
 	}
 	log(g, "generating options for message: ", message.GoIdent.GoName)
 
-	// Declare the Option interface for this message
+	// Declare the Option type for this message, an alias of the runtime Option so generic helpers accept it
 	g.P(fmt.Sprintf("// %sOption defines a functional option for %s.", message.GoIdent.GoName, message.GoIdent.GoName))
-	g.P(fmt.Sprintf("type %sOption func(*%s)", message.GoIdent.GoName, message.GoIdent.GoName))
+	if *disableRuntime {
+		g.P(fmt.Sprintf("type %sOption func(*%s)", message.GoIdent.GoName, message.GoIdent.GoName))
+	} else {
+		g.P(fmt.Sprintf("type %sOption = %s[*%s]", message.GoIdent.GoName, g.QualifiedGoIdent(optionsPackage.Ident("Option")), message.GoIdent.GoName))
+	}
 	g.P()
 
 	if !optionFlagForMessage(message, GO_OPTIONS_SKIP_INIT) {
-		constructorName := fmt.Sprintf("New%s", message.GoIdent.GoName)
+		constructorName := fmt.Sprintf("%s%s", *constructorPrefix, message.GoIdent.GoName)
 		g.P(fmt.Sprintf("// %s creates a new %s.", constructorName, message.GoIdent.GoName))
 		g.P(fmt.Sprintf("func %s(opts ...%s) *%s {", constructorName, qualifiedIdentForName(g, message.GoIdent, "", "Option"), message.GoIdent.GoName))
 		g.P(fmt.Sprintf("\tm := &%s{}", message.GoIdent.GoName))
@@ -222,56 +316,124 @@ func generateOptionsForMessage(g *protogen.GeneratedFile, message *protogen.Mess
 		g.P("\treturn m")
 		g.P("}")
 		g.P()
+
+		// CloneMessageWith leaves m untouched, so shared or cached messages can be used as a template
+		cloneName := fmt.Sprintf("Clone%sWith", message.GoIdent.GoName)
+		g.P(fmt.Sprintf("// %s returns a copy of m with the provided options applied, m itself is not modified.", cloneName))
+		g.P(fmt.Sprintf("func %s(m *%s, opts ...%s) *%s {", cloneName, message.GoIdent.GoName, qualifiedIdentForName(g, message.GoIdent, "", "Option"), message.GoIdent.GoName))
+		g.P("\tc := ", protoPackage.Ident("Clone"), fmt.Sprintf("(m).(*%s)", message.GoIdent.GoName))
+		g.P("\tfor _, opt := range opts {")
+		g.P("\t\topt(c)")
+		g.P("\t}")
+		g.P("\treturn c")
+		g.P("}")
+		g.P()
+	}
+
+	if generatesErrorOptions(message) {
+		generateErrorOptionsForMessage(g, message)
+	}
+	if hasValidateRules(message) {
+		generateValidatedConstructor(g, message)
+	}
+	if hasRequiredFields(message) {
+		generateCheckedConstructors(g, message)
+	}
+	if hasDefaults(message) {
+		generateDefaultsOptions(g, message, names)
 	}
 
-	generateFieldOptions(g, message, collisionMap)
-	generateOneOfOptions(g, message, collisionMap)
+	generateFieldOptions(g, message, names)
+	generateOneOfOptions(g, message, names)
+
+	if generatesBuilders(message) {
+		generateBuilder(g, message, names)
+	}
 }
 
-func generateFieldOptions(g *protogen.GeneratedFile, message *protogen.Message, collisionMap map[string]int) {
+func generateFieldOptions(g *protogen.GeneratedFile, message *protogen.Message, names optionNames) {
 	log(g, "generating field options for message: ", message.GoIdent.GoName)
 	for _, field := range message.Fields {
 		// Skip fields that belong to a oneof group
 		if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
 			continue
 		}
-
-		optionName := fmt.Sprintf("With%s", field.GoName)
-		if collisionMap[field.GoName] > 1 {
-			optionName = fmt.Sprintf("%sFor%s", optionName, message.GoIdent.GoName)
+		if optionFlagForField(field, GO_OPTIONS_SKIP) {
+			log(g, "skipping field: ", field.GoName)
+			continue
 		}
 
-		if optionFlagForField(field, GO_OPTIONS_JSON_PERSISTENT) {
+		optionName := fieldOptionName(*optionPrefix, message, field, "", names)
+
+		if jsonPersistent(field) {
 			generateJsonMethods(g, message, field)
 		}
+		for _, encoding := range persistenceEncodings {
+			if optionFlagForField(field, encoding.flag) {
+				generatePersistenceMethods(g, message, field, encoding)
+			}
+		}
+		if sqlEncoding(field) != gooptions.SqlEncoding_SQL_ENCODING_UNSPECIFIED {
+			generateSqlWrapper(g, message, field)
+		}
 
 		if field.Desc.IsMap() {
 			generateMapFieldOption(g, message, field, optionName)
+			generateMapFieldPutOption(g, message, field, fieldOptionName("Put", message, field, "", names))
+			generateMapFieldMergeOption(g, message, field, fieldOptionName("Merge", message, field, "", names))
+			if !*disableWkt {
+				generateTimeCollectionOption(g, message, field, fmt.Sprintf("%sNew%sFor%s", *optionPrefix, field.GoName, message.GoIdent.GoName))
+			}
 		} else if field.Desc.IsList() {
 			generateRepeatedFieldOption(g, message, field, optionName)
+			generateRepeatedFieldAddOption(g, message, field, fieldOptionName("Add", message, field, "", names))
+			if !*disableWkt {
+				generateTimeCollectionOption(g, message, field, fmt.Sprintf("%sNew%sFor%s", *optionPrefix, field.GoName, message.GoIdent.GoName))
+			}
 		} else if field.Desc.Kind() == protoreflect.MessageKind {
-			generateNestedFieldOption(g, message, field, fmt.Sprintf("WithNew%sFor%s", field.GoName, message.GoIdent.GoName))
+			generateNestedFieldOption(g, message, field, fmt.Sprintf("%sNew%sFor%s", *optionPrefix, field.GoName, message.GoIdent.GoName))
 			generateDirectNestedFieldOption(g, message, field, optionName)
 		} else {
 			generateScalarFieldOption(g, message, field, optionName)
+			if field.Desc.Kind() == protoreflect.EnumKind {
+				generateEnumValueOptions(g, message, field, names)
+			}
+		}
+		generateClearFieldOption(g, message, field, fieldOptionName("Without", message, field, "", names))
+		if generatesErrorOptions(message) {
+			generateFieldErrorOption(g, message, field, names)
 		}
 	}
 }
 
-func generateOneOfOptions(g *protogen.GeneratedFile, message *protogen.Message, collisionMap map[string]int) {
+// fieldOptionName returns the name of an option for the field, made up of the prefix, field name and suffix.
+// When the names qualify the field the message name is appended to keep the name unique.
+func fieldOptionName(prefix string, message *protogen.Message, field *protogen.Field, suffix string, names optionNames) string {
+	name := prefix + fieldBaseName(field) + suffix
+	if names[fieldNameKey(field)] {
+		name = fmt.Sprintf("%sFor%s", name, message.GoIdent.GoName)
+	}
+	return name
+}
+
+func generateOneOfOptions(g *protogen.GeneratedFile, message *protogen.Message, names optionNames) {
 	log(g, "generating oneof options for message: ", message.GoIdent.GoName)
 	for _, oneof := range message.Oneofs {
 		if oneof.Desc.IsSynthetic() {
 			continue
 		}
+		if optionFlagForOneof(oneof, GO_OPTIONS_SKIP) {
+			log(g, "skipping oneof: ", oneof.GoName)
+			continue
+		}
 
 		for _, field := range oneof.Fields {
-
-			fieldWrapperType := fmt.Sprintf("%s_%s", message.GoIdent.GoName, field.GoName)
-			optionName := fmt.Sprintf("With%s", field.GoName)
-			if collisionMap[field.GoName] > 1 {
-				optionName = fmt.Sprintf("%sFor%s", optionName, message.GoIdent.GoName)
+			if optionFlagForField(field, GO_OPTIONS_SKIP) {
+				log(g, "skipping oneof field: ", field.GoName)
+				continue
 			}
+
+			optionName := fieldOptionName(*optionPrefix, message, field, "", names)
 			g.P(fmt.Sprintf("// %s sets the %s oneof field to %s.", optionName, oneof.GoName, field.GoName))
 			if field.Desc.IsList() {
 				log(g, "oneof field is a list")
@@ -281,171 +443,2043 @@ func generateOneOfOptions(g *protogen.GeneratedFile, message *protogen.Message,
 				g.P(fmt.Sprintf("func %s(value %s) %s {", optionName, determineFieldType(g, field), qualifiedIdentForName(g, message.GoIdent, "", "Option")))
 			}
 			g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
-			// Assign the wrapper struct for oneof fields
-			if field.Desc.IsList() {
-				log(g, "oneof field is a list")
-				g.P(fmt.Sprintf("\t\tm.%s = &%s{\n\t\t\t%s: value,\n\t\t}", oneof.GoName, fieldWrapperType, field.GoName))
-			} else {
-				log(g, "oneof field is not a list")
-				g.P(fmt.Sprintf("\t\tm.%s = &%s{\n\t\t\t%s: value,\n\t\t}", oneof.GoName, fieldWrapperType, field.GoName))
-			}
+			g.P("\t\t", fieldAssignment(message, field, "value"))
 			g.P("\t}")
 			g.P("}")
 			g.P()
+
+			if field.Desc.Kind() == protoreflect.MessageKind {
+				generateNestedFieldOption(g, message, field, fmt.Sprintf("%sNew%sFor%s", *optionPrefix, field.GoName, message.GoIdent.GoName))
+			}
 		}
+
+		generateClearOneofOption(g, message, oneof, oneofOptionName("Without", message, oneof, names))
 	}
 }
 
-func wellKnownPath(ident protogen.GoIdent) bool {
-	return strings.Contains(ident.GoImportPath.String(), "protobuf/types/known")
+// oneofCollisionKey returns the key oneofs are counted under in the collision map,
+// kept apart from the field names so a oneof never renames the options of a field.
+func oneofCollisionKey(oneof *protogen.Oneof) string {
+	if oneof.Desc.IsSynthetic() {
+		return ""
+	}
+	return "oneof " + oneof.GoName
 }
 
-func wellKnown(g *protogen.GeneratedFile, ident protogen.GoIdent) bool {
-	log(g, "checking if well known type: ", ident.GoImportPath.String())
-	return wellKnownPath(ident)
+// oneofOptionName returns the name of an option for the oneof group, made up of the prefix and oneof name.
+// When the names qualify the oneof the message name is appended.
+func oneofOptionName(prefix string, message *protogen.Message, oneof *protogen.Oneof, names optionNames) string {
+	name := prefix + oneof.GoName
+	if names[oneofNameKey(oneof)] {
+		name = fmt.Sprintf("%sFor%s", name, message.GoIdent.GoName)
+	}
+	return name
 }
 
-func generateNestedFieldOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, optionName string) {
-	// we need to check if the message field is optionless
-	optionless := optionFlagForMessage(field.Message, GO_OPTIONS_OPTIONLESS)
-	log(g, "generating nested field option for message: ", message.GoIdent.GoName, ", optionless: ", optionless)
-	g.P(fmt.Sprintf("// %s sets the %s field with a new instance.", optionName, field.GoName))
-	if optionless {
-		g.P(fmt.Sprintf("func %s() %s {", optionName, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
-	} else {
-		if wellKnown(g, field.Message.GoIdent) && field.Message.GoIdent.GoName == "Timestamp" {
-			log(g, "field is a well known type: timestamp")
-			g.P(fmt.Sprintf("func %s(v time.Time) %s {", optionName, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
-			g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
-			g.P(fmt.Sprintf("\t\tm.%s = timestamppb.New(v)", field.GoName))
-			g.P("\t}")
-			g.P("}")
-			return
+// optionNames records for every field, oneof and message with defaults whether its option names
+// are qualified with For<Message>, keyed by fieldNameKey, oneofNameKey and defaultsNameKey.
+// Fields with an explicit name are never qualified and have no entry.
+type optionNames map[string]bool
+
+// fieldNameKey, oneofNameKey and defaultsNameKey return the keys of the option names, also used as the entries of the names lock.
+func fieldNameKey(field *protogen.Field) string { return "field " + string(field.Desc.FullName()) }
+
+func oneofNameKey(oneof *protogen.Oneof) string { return "oneof " + string(oneof.Desc.FullName()) }
+
+func defaultsNameKey(message *protogen.Message) string {
+	return "defaults " + string(message.Desc.FullName())
+}
+
+// explicitFieldName returns the name set through (go_options.field).name, or an empty string.
+func explicitFieldName(field *protogen.Field) string {
+	opts, _ := proto.GetExtension(field.Desc.Options(), gooptions.E_Field).(*gooptions.FieldOptions)
+	return opts.GetName()
+}
+
+// fieldBaseName returns the name the options of the field are derived from, the explicit name or the Go field name.
+func fieldBaseName(field *protogen.Field) string {
+	if name := explicitFieldName(field); name != "" {
+		return name
+	}
+	return field.GoName
+}
+
+// validateFieldName checks that an explicit name results in exported option names.
+func validateFieldName(field *protogen.Field) error {
+	name := explicitFieldName(field)
+	if name == "" || (token.IsIdentifier(name) && token.IsExported(name)) {
+		return nil
+	}
+	return fmt.Errorf("%s: option (go_options.field).name must be an exported Go identifier, got %q", field.Desc.FullName(), name)
+}
+
+// resolveOptionNames decides which option names are qualified with For<Message>.
+// A name in the lock keeps its form, new names are qualified when the qualified_names parameter is set
+// or when the name occurs more than once in the Go package. Explicit names are never qualified.
+func resolveOptionNames(gen *protogen.Plugin, locked optionNames) (optionNames, error) {
+	packageCounts := make(map[protogen.GoPackageName]map[string]int)
+	for _, file := range gen.Files {
+		if !file.Generate {
+			continue
 		}
-		if field.Message.GoIdent.GoName == "Date" && strings.Contains(field.Message.GoIdent.GoImportPath.String(), "googleapis/type/date") {
-			log(g, "field is a googleapis type: date")
-			dateIdent := g.QualifiedGoIdent(field.Message.GoIdent)
-			g.P(fmt.Sprintf("func %s(v time.Time) %s {", optionName, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
-			g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
-			g.P(fmt.Sprintf("\t\tm.%s = &%s{", field.GoName, dateIdent))
-			g.P("\t\t\tYear: int32(v.Year()),")
-			g.P("\t\t\tMonth: int32(v.Month()),")
-			g.P("\t\t\tDay: int32(v.Day()),")
-			g.P("\t\t}")
-			g.P("\t}")
-			g.P("}")
-			return
+		if packageCounts[file.GoPackageName] == nil {
+			packageCounts[file.GoPackageName] = make(map[string]int)
 		}
-		if wellKnown(g, field.Message.GoIdent) && field.Message.GoIdent.GoName == "Duration" {
-			log(g, "field is a well known type: duration")
-			g.P(fmt.Sprintf("func %s(v time.Duration) %s {", optionName, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
-			g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
-			g.P(fmt.Sprintf("\t\tm.%s = durationpb.New(v)", field.GoName))
-			g.P("\t}")
-			g.P("}")
-			return
+		counts := packageCounts[file.GoPackageName]
+		for _, msg := range allMessages(file.Messages) {
+			for _, field := range msg.Fields {
+				counts[fieldBaseName(field)]++
+				for _, value := range enumOptionValues(field) {
+					counts[fieldBaseName(field)+enumValueSuffix(field.Enum, value)]++
+				}
+			}
+			for _, oneof := range msg.Oneofs {
+				counts[oneofCollisionKey(oneof)]++
+			}
+			if hasDefaults(msg) {
+				counts[defaultsCollisionKey]++
+			}
 		}
-		if wellKnown(g, field.Message.GoIdent) && field.Message.GoIdent.GoName == "FieldMask" {
-			log(g, "field is a well known type: fieldmask")
-			fmIdent := g.QualifiedGoIdent(field.Message.GoIdent)
-			g.P(fmt.Sprintf("func %s(paths ...string) %s {", optionName, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
-			g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
-			g.P(fmt.Sprintf("\t\tm.%s = &%s{Paths: paths}", field.GoName, fmIdent))
-			g.P("\t}")
-			g.P("}")
-			return
+	}
+
+	qualify := func(key string, collides bool) bool {
+		if qualified, ok := locked[key]; ok {
+			return qualified
 		}
-		g.P(fmt.Sprintf("func %s(opts ...%s) %s {", optionName, qualifiedIdentForName(g, field.Message.GoIdent, "", "Option"), qualifiedIdentForName(g, message.GoIdent, "", "Option")))
+		return *qualifiedNames || collides
 	}
-	g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
-	if optionless {
-		g.P(fmt.Sprintf("\t\tm.%s = %s()", field.GoName, qualifiedIdentForName(g, field.Message.GoIdent, "New", "")))
-	} else {
-		g.P(fmt.Sprintf("\t\tm.%s = %s(opts...)", field.GoName, qualifiedIdentForName(g, field.Message.GoIdent, "New", "")))
+
+	names := make(optionNames)
+	// unqualified names must stay unique in the package, the lock and explicit names can break that
+	packageNames := make(map[protogen.GoPackageName]map[string]string)
+	claim := func(pkg protogen.GoPackageName, name, key string) error {
+		if packageNames[pkg] == nil {
+			packageNames[pkg] = make(map[string]string)
+		}
+		if other, ok := packageNames[pkg][name]; ok {
+			return fmt.Errorf("%s and %s both generate the option names for %s, set a different (go_options.field).name or remove one of them from the names lock", other, key, name)
+		}
+		packageNames[pkg][name] = key
+		return nil
 	}
-	g.P("\t}")
-	g.P("}")
-	g.P()
+	for _, file := range gen.Files {
+		if !file.Generate {
+			continue
+		}
+		counts := packageCounts[file.GoPackageName]
+		for _, msg := range allMessages(file.Messages) {
+			for _, field := range msg.Fields {
+				key := fieldNameKey(field)
+				values := enumOptionValues(field)
+				// the enum value options share the qualification of the field
+				claimField := func(name, qualifier string) error {
+					if err := claim(file.GoPackageName, name+qualifier, key); err != nil {
+						return err
+					}
+					for _, value := range values {
+						if err := claim(file.GoPackageName, name+enumValueSuffix(field.Enum, value)+qualifier, "enum value "+string(value.Desc.FullName())+" of "+key); err != nil {
+							return err
+						}
+					}
+					return nil
+				}
+				if name := explicitFieldName(field); name != "" {
+					delete(locked, key)
+					if err := claimField(name, ""); err != nil {
+						return nil, err
+					}
+					continue
+				}
+				collides := counts[field.GoName] > 1
+				for _, value := range values {
+					collides = collides || counts[field.GoName+enumValueSuffix(field.Enum, value)] > 1
+				}
+				names[key] = qualify(key, collides)
+				qualifier := ""
+				if names[key] {
+					qualifier = "For" + msg.GoIdent.GoName
+				}
+				if err := claimField(field.GoName, qualifier); err != nil {
+					return nil, err
+				}
+			}
+			for _, oneof := range msg.Oneofs {
+				if oneof.Desc.IsSynthetic() {
+					continue
+				}
+				key := oneofNameKey(oneof)
+				names[key] = qualify(key, counts[oneof.GoName]+counts[oneofCollisionKey(oneof)] > 1)
+				if !names[key] {
+					if err := claim(file.GoPackageName, oneof.GoName, key); err != nil {
+						return nil, err
+					}
+				}
+			}
+			if hasDefaults(msg) {
+				key := defaultsNameKey(msg)
+				names[key] = qualify(key, counts[defaultsCollisionKey] > 1)
+				if !names[key] {
+					if err := claim(file.GoPackageName, defaultsCollisionKey, key); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+	}
+	return names, nil
 }
 
-// qualifiedIdentForName takes the protogen.GoIdent and will return a qualified Go identifier with the given prefix and suffix
-// examples: prefix "New" for GoIdent "Foo" in an external package "foo" will return "foo.NewFoo"
-// example: suffix "Option" for GoIdent "Bar" in the same package will return "BarOption"
-func qualifiedIdentForName(g *protogen.GeneratedFile, ident protogen.GoIdent, prefix string, suffix string) string {
-	log(g, "qualifying identifier for name: ", ident.GoName)
-	return g.QualifiedGoIdent(ident.GoImportPath.Ident(prefix + ident.GoName + suffix))
+// readNamesLock reads the names lock from the working directory, a missing file is an empty lock.
+// Every line holds the kind, full name and form of a name, e.g. "field example.Foo.id unqualified",
+// empty lines and lines starting with # are ignored.
+func readNamesLock() (optionNames, error) {
+	locked := make(optionNames)
+	if *namesLock == "" {
+		return locked, nil
+	}
+	data, err := os.ReadFile(*namesLock)
+	if errors.Is(err, fs.ErrNotExist) {
+		return locked, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading names lock: %w", err)
+	}
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		entry := strings.Fields(line)
+		if len(entry) != 3 || (entry[0] != "field" && entry[0] != "oneof" && entry[0] != "defaults") ||
+			(entry[2] != "qualified" && entry[2] != "unqualified") {
+			return nil, fmt.Errorf("%s:%d: invalid names lock entry %q", *namesLock, i+1, line)
+		}
+		locked[entry[0]+" "+entry[1]] = entry[2] == "qualified"
+	}
+	return locked, nil
 }
 
-func generateDirectNestedFieldOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, optionName string) {
-	log(g, "generating direct nested field option for message: ", message.GoIdent.GoName)
-	g.P(fmt.Sprintf("// %s sets the %s field directly.", optionName, field.GoName))
-	g.P(fmt.Sprintf("func %s(value *%s) %s {", optionName, g.QualifiedGoIdent(field.Message.GoIdent), qualifiedIdentForName(g, message.GoIdent, "", "Option")))
+// generateNamesLock writes the names lock with the names of this run added to the locked names,
+// entries of files outside this run are kept so the lock can be shared by several invocations.
+func generateNamesLock(gen *protogen.Plugin, locked, names optionNames) {
+	merged := make(optionNames, len(locked)+len(names))
+	for key, qualified := range locked {
+		merged[key] = qualified
+	}
+	for key, qualified := range names {
+		merged[key] = qualified
+	}
+	keys := make([]string, 0, len(merged))
+	for key := range merged {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	g := gen.NewGeneratedFile(*namesLock, "")
+	g.P("# Option names issued by protoc-gen-go-options, qualified names end in For<Message>.")
+	g.P("# Commit this file to keep the names stable, remove an entry to let the plugin choose again.")
+	for _, key := range keys {
+		form := "unqualified"
+		if merged[key] {
+			form = "qualified"
+		}
+		g.P(key, " ", form)
+	}
+}
+
+// generateClearFieldOption generates an option resetting the field, so overrides can unset values of an existing message.
+func generateClearFieldOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, optionName string) {
+	log(g, "generating clear field option for message: ", message.GoIdent.GoName)
+	g.P(fmt.Sprintf("// %s clears the %s field.", optionName, field.GoName))
+	g.P(fmt.Sprintf("func %s() %s {", optionName, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
 	g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
-	g.P(fmt.Sprintf("\t\tm.%s = value", field.GoName))
+	switch {
+	case usesSetters(message) && field.Desc.HasPresence():
+		clear, _ := field.MethodName("Clear")
+		g.P(fmt.Sprintf("\t\tm.%s()", clear))
+	case field.Desc.IsList() || field.Desc.IsMap() || field.Desc.HasPresence():
+		g.P("\t\t", fieldAssignment(message, field, "nil"))
+	default:
+		g.P("\t\t", fieldAssignment(message, field, zeroValue(field)))
+	}
 	g.P("\t}")
 	g.P("}")
 	g.P()
 }
 
-func generateScalarFieldOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, optionName string) {
-	log(g, "generating scalar field option for message: ", message.GoIdent.GoName)
-	fieldType := determineFieldType(g, field)
-	g.P(fmt.Sprintf("// %s sets the %s field.", optionName, field.GoName))
-	if field.Desc.IsList() {
-		log(g, "field is a list")
-		g.P(fmt.Sprintf("func %s(value ...%s) %s {", optionName, fieldType, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
-	} else if field.Desc.Kind() == protoreflect.EnumKind {
-		log(g, "field is an enum")
-		g.P(fmt.Sprintf("func %s(value *%s) %s {", optionName, fieldType, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
-	} else {
-		log(g, "field is not a list")
-		g.P(fmt.Sprintf("func %s(value %s) %s {", optionName, fieldType, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
-	}
-	g.P(fmt.Sprintf("\treturn func(m *%s) {", g.QualifiedGoIdent(message.GoIdent)))
-	if field.Desc.IsList() {
-		log(g, "field is a list")
-		g.P(fmt.Sprintf("\t\tm.%s = value", field.GoName))
-	} else if protoHelperFunc(field.Desc.Kind()) != "" {
-		log(g, "field is a scalar")
-		g.P(fmt.Sprintf("\t\tm.%s = proto.%s(value)", field.GoName, protoHelperFunc(field.Desc.Kind())))
-	} else if field.Desc.Kind() == protoreflect.EnumKind {
-		log(g, "field is an enum")
-		g.P(fmt.Sprintf("\t\tm.%s = value", field.GoName))
+// generateClearOneofOption generates an option clearing whichever member of the oneof is set.
+func generateClearOneofOption(g *protogen.GeneratedFile, message *protogen.Message, oneof *protogen.Oneof, optionName string) {
+	log(g, "generating clear oneof option for message: ", message.GoIdent.GoName)
+	g.P(fmt.Sprintf("// %s clears the %s oneof field.", optionName, oneof.GoName))
+	g.P(fmt.Sprintf("func %s() %s {", optionName, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
+	g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
+	if usesSetters(message) {
+		g.P(fmt.Sprintf("\t\tm.%s()", oneof.MethodName("Clear")))
 	} else {
-		log(g, "field is an interface")
-		g.P(fmt.Sprintf("\t\tm.%s = value", field.GoName))
+		g.P(fmt.Sprintf("\t\tm.%s = nil", oneof.GoName))
 	}
 	g.P("\t}")
 	g.P("}")
 	g.P()
 }
 
-func generateRepeatedFieldOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, optionName string) {
-	log(g, "generating repeated field option for message: ", message.GoIdent.GoName)
-	elementType := determineFieldType(g, field)
-	g.P(fmt.Sprintf("// %s sets the %s field.", optionName, field.GoName))
-	g.P(fmt.Sprintf("func %s(values ...%s) %s {", optionName, elementType, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
-	g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
-	g.P(fmt.Sprintf("\t\tm.%s = values", field.GoName))
-	g.P("\t}")
-	g.P("}")
-	g.P()
+// zeroValue returns the Go zero value for a singular field without presence.
+func zeroValue(field *protogen.Field) string {
+	switch field.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return "false"
+	case protoreflect.StringKind:
+		return `""`
+	case protoreflect.BytesKind, protoreflect.MessageKind, protoreflect.GroupKind:
+		return "nil"
+	default:
+		return "0"
+	}
 }
 
-func generateMapFieldOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, optionName string) {
+func wellKnownPath(ident protogen.GoIdent) bool {
+	return strings.Contains(ident.GoImportPath.String(), "protobuf/types/known")
+}
+
+// typeConvenience reports whether generateNestedFieldOption has a native Go setter for the message type
+// instead of forwarding to the options of the message.
+func typeConvenience(message *protogen.Message) bool {
+	ident := message.GoIdent
+	if wellKnownPath(ident) {
+		switch ident.GoName {
+		case "Timestamp", "Duration", "FieldMask", "Struct", "Value", "Any", "Empty":
+			return true
+		}
+		_, ok := wrapperTypes[ident.GoName]
+		return ok
+	}
+	switch googleType(message) {
+	case "Date", "Money", "LatLng", "TimeOfDay", "DateTime", "Interval", "Decimal":
+		return true
+	}
+	return false
+}
+
+// googleType returns the name of a message declared in the google.type package of the googleapis common types,
+// or an empty name for any other message.
+func googleType(message *protogen.Message) protoreflect.Name {
+	if message.Desc.FullName().Parent() != "google.type" {
+		return ""
+	}
+	return message.Desc.Name()
+}
+
+// wrapperType is the Go type held by a wrapperspb message and the wrapperspb function creating the message.
+type wrapperType struct {
+	goType      string
+	constructor string
+}
+
+// wrapperTypes maps the wrapperspb messages to the Go type they wrap.
+var wrapperTypes = map[string]wrapperType{
+	"DoubleValue": {"float64", "Double"},
+	"FloatValue":  {"float32", "Float"},
+	"Int64Value":  {"int64", "Int64"},
+	"UInt64Value": {"uint64", "UInt64"},
+	"Int32Value":  {"int32", "Int32"},
+	"UInt32Value": {"uint32", "UInt32"},
+	"BoolValue":   {"bool", "Bool"},
+	"StringValue": {"string", "String"},
+	"BytesValue":  {"[]byte", "Bytes"},
+}
+
+func generateNestedFieldOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, optionName string) {
+	// we need to check if the message field is optionless
+	optionless := optionFlagForMessage(field.Message, GO_OPTIONS_OPTIONLESS)
+	log(g, "generating nested field option for message: ", message.GoIdent.GoName, ", optionless: ", optionless)
+	if typeConvenience(field.Message) || !generatesOptions(field.Message) {
+		// well-known and googleapis types have no generated options to forward to, so the types without a convenience only get the direct option
+		if *disableWkt || !typeConvenience(field.Message) {
+			log(g, "skipping well known type convenience for field: ", field.GoName)
+			return
+		}
+		generateTypeConvenienceOption(g, message, field, optionName)
+		return
+	}
+	g.P(fmt.Sprintf("// %s sets the %s field with a new instance.", optionName, field.GoName))
+	if optionless {
+		g.P(fmt.Sprintf("func %s() %s {", optionName, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
+	} else {
+		g.P(fmt.Sprintf("func %s(opts ...%s) %s {", optionName, qualifiedIdentForName(g, field.Message.GoIdent, "", "Option"), qualifiedIdentForName(g, message.GoIdent, "", "Option")))
+	}
+	g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
+	if optionless {
+		g.P("\t\t", fieldAssignment(message, field, qualifiedIdentForName(g, field.Message.GoIdent, *constructorPrefix, "")+"()"))
+	} else {
+		g.P("\t\t", fieldAssignment(message, field, qualifiedIdentForName(g, field.Message.GoIdent, *constructorPrefix, "")+"(opts...)"))
+	}
+	g.P("\t}")
+	g.P("}")
+	g.P()
+}
+
+// timeMessage reports whether the message is a Timestamp, Duration or google.type.Date, which are set from the time package types.
+func timeMessage(message *protogen.Message) bool {
+	ident := message.GoIdent
+	if wellKnownPath(ident) {
+		return ident.GoName == "Timestamp" || ident.GoName == "Duration"
+	}
+	return googleType(message) == "Date"
+}
+
+// timeConversion returns the time package type a time message is set from
+// and the expression converting a value of that type named v into the message.
+func timeConversion(g *protogen.GeneratedFile, message *protogen.Message) (string, string) {
+	ident := message.GoIdent
+	switch ident.GoName {
+	case "Duration":
+		return g.QualifiedGoIdent(timePackage.Ident("Duration")), g.QualifiedGoIdent(ident.GoImportPath.Ident("New")) + "(v)"
+	case "Date":
+		return g.QualifiedGoIdent(timePackage.Ident("Time")), fmt.Sprintf("&%s{\n\t\t\tYear: int32(v.Year()),\n\t\t\tMonth: int32(v.Month()),\n\t\t\tDay: int32(v.Day()),\n\t\t}", g.QualifiedGoIdent(ident))
+	default:
+		return g.QualifiedGoIdent(timePackage.Ident("Time")), g.QualifiedGoIdent(ident.GoImportPath.Ident("New")) + "(v)"
+	}
+}
+
+// generateTypeConvenienceOption generates the option of a well-known or google.type field taking the native Go value.
+func generateTypeConvenienceOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, optionName string) {
+	if googleType(field.Message) != "" {
+		generateGoogleTypeOption(g, message, field, optionName)
+		return
+	}
+	ident := field.Message.GoIdent
+	optionType := qualifiedIdentForName(g, message.GoIdent, "", "Option")
+	var param, value string
+	switch ident.GoName {
+	case "Timestamp", "Duration":
+		log(g, "field is a time type: ", ident.GoName)
+		g.P(fmt.Sprintf("// %s sets the %s field with a new instance.", optionName, field.GoName))
+		goType, convert := timeConversion(g, field.Message)
+		param, value = "v "+goType, convert
+	case "FieldMask":
+		log(g, "field is a well known type: fieldmask")
+		g.P(fmt.Sprintf("// %s sets the %s field with a new instance.", optionName, field.GoName))
+		param, value = "paths ...string", fmt.Sprintf("&%s{Paths: paths}", g.QualifiedGoIdent(ident))
+	case "Empty":
+		log(g, "field is a well known type: empty")
+		g.P(fmt.Sprintf("// %s sets the %s field to an empty message.", optionName, field.GoName))
+		param, value = "", fmt.Sprintf("&%s{}", g.QualifiedGoIdent(ident))
+	case "Struct", "Value", "Any":
+		generateFallibleConvenienceOption(g, message, field, optionName)
+		return
+	default:
+		wrapper := wrapperTypes[ident.GoName]
+		log(g, "field is a well known type: ", ident.GoName)
+		article := "a"
+		if strings.ContainsRune("AEIOU", rune(ident.GoName[0])) {
+			article = "an"
+		}
+		g.P(fmt.Sprintf("// %s sets the %s field to v wrapped in %s %s.", optionName, field.GoName, article, ident.GoName))
+		param, value = "v "+wrapper.goType, g.QualifiedGoIdent(ident.GoImportPath.Ident(wrapper.constructor))+"(v)"
+	}
+	g.P(fmt.Sprintf("func %s(%s) %s {", optionName, param, optionType))
+	g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
+	g.P("\t\t", fieldAssignment(message, field, value))
+	g.P("\t}")
+	g.P("}")
+	g.P()
+}
+
+// generateFallibleConvenienceOption generates the option of a Struct, Value or Any field.
+// Converting the Go value can fail, so the value is converted when the option is created and the error is returned with it.
+func generateFallibleConvenienceOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, optionName string) {
+	ident := field.Message.GoIdent
+	var param, constructor string
+	switch ident.GoName {
+	case "Struct":
+		log(g, "field is a well known type: struct")
+		g.P(fmt.Sprintf("// %s sets the %s field to a Struct converted from v, values that cannot be converted are rejected.", optionName, field.GoName))
+		param, constructor = "v map[string]any", "NewStruct"
+	case "Value":
+		log(g, "field is a well known type: value")
+		g.P(fmt.Sprintf("// %s sets the %s field to a Value converted from v, values that cannot be converted are rejected.", optionName, field.GoName))
+		param, constructor = "v any", "NewValue"
+	case "Any":
+		log(g, "field is a well known type: any")
+		g.P(fmt.Sprintf("// %s sets the %s field to v packed into an Any, messages that cannot be marshaled are rejected.", optionName, field.GoName))
+		param, constructor = "v "+g.QualifiedGoIdent(protoPackage.Ident("Message")), "New"
+	}
+	g.P(fmt.Sprintf("func %s(%s) (%s, error) {", optionName, param, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
+	g.P(fmt.Sprintf("\tvalue, err := %s(v)", g.QualifiedGoIdent(ident.GoImportPath.Ident(constructor))))
+	g.P("\tif err != nil {")
+	g.P("\t\treturn nil, err")
+	g.P("\t}")
+	g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
+	g.P("\t\t", fieldAssignment(message, field, "value"))
+	g.P("\t}, nil")
+	g.P("}")
+	g.P()
+}
+
+// googleTypeFields are the fields of the google.type messages the generated options set.
+var googleTypeFields = map[protoreflect.Name][]protoreflect.Name{
+	"Date":      {"year", "month", "day"},
+	"Money":     {"currency_code", "units", "nanos"},
+	"LatLng":    {"latitude", "longitude"},
+	"TimeOfDay": {"hours", "minutes", "seconds", "nanos"},
+	"DateTime":  {"year", "month", "day", "hours", "minutes", "seconds", "nanos", "utc_offset", "time_zone"},
+	"Interval":  {"start_time", "end_time"},
+	"Decimal":   {"value"},
+}
+
+// validateGoogleType rejects a field holding a google.type message that lacks a field the generated options set,
+// such as a google.type package that is not the googleapis one.
+func validateGoogleType(field *protogen.Field) error {
+	if field.Message == nil {
+		return nil
+	}
+	for _, name := range googleTypeFields[googleType(field.Message)] {
+		if field.Message.Desc.Fields().ByName(name) == nil {
+			return fmt.Errorf("%s: %s has no field %s", field.Desc.FullName(), field.Message.Desc.FullName(), name)
+		}
+	}
+	return nil
+}
+
+// googleTypeField returns the field of a google.type message with the given name,
+// validateGoogleType made sure the fields in googleTypeFields exist.
+func googleTypeField(message *protogen.Message, name protoreflect.Name) *protogen.Field {
+	for _, field := range message.Fields {
+		if field.Desc.Name() == name {
+			return field
+		}
+	}
+	return nil
+}
+
+// googleTypeValue returns the parameters of the option setting a google.type message from Go values
+// and the expression building the message from them. DateTime is built by generateDateTimeOption instead.
+func googleTypeValue(g *protogen.GeneratedFile, message *protogen.Message) (string, string) {
+	ident := g.QualifiedGoIdent(message.GoIdent)
+	switch googleType(message) {
+	case "Money":
+		return "currencyCode string, units int64, nanos int32", fmt.Sprintf("&%s{CurrencyCode: currencyCode, Units: units, Nanos: nanos}", ident)
+	case "LatLng":
+		return "latitude, longitude float64", fmt.Sprintf("&%s{Latitude: latitude, Longitude: longitude}", ident)
+	case "TimeOfDay":
+		return "v " + g.QualifiedGoIdent(timePackage.Ident("Time")),
+			fmt.Sprintf("&%s{\n\t\t\tHours: int32(v.Hour()),\n\t\t\tMinutes: int32(v.Minute()),\n\t\t\tSeconds: int32(v.Second()),\n\t\t\tNanos: int32(v.Nanosecond()),\n\t\t}", ident)
+	case "Interval":
+		newTimestamp := g.QualifiedGoIdent(googleTypeField(message, "start_time").Message.GoIdent.GoImportPath.Ident("New"))
+		return "start, end " + g.QualifiedGoIdent(timePackage.Ident("Time")),
+			fmt.Sprintf("&%s{StartTime: %s(start), EndTime: %s(end)}", ident, newTimestamp, newTimestamp)
+	case "Decimal":
+		return "v *" + g.QualifiedGoIdent(bigPackage.Ident("Float")), fmt.Sprintf("&%s{Value: v.Text('g', -1)}", ident)
+	default:
+		goType, value := timeConversion(g, message)
+		return "v " + goType, value
+	}
+}
+
+// generateGoogleTypeOption generates the option of a google.type field taking the Go values the type represents.
+func generateGoogleTypeOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, optionName string) {
+	log(g, "field is a googleapis type: ", googleType(field.Message))
+	switch googleType(field.Message) {
+	case "Money":
+		g.P(fmt.Sprintf("// %s sets the %s field to the amount of whole units and nanos, billionths of a unit, in the currency.", optionName, field.GoName))
+	case "LatLng":
+		g.P(fmt.Sprintf("// %s sets the %s field to the latitude and longitude in degrees.", optionName, field.GoName))
+	case "TimeOfDay":
+		g.P(fmt.Sprintf("// %s sets the %s field to the clock time of v.", optionName, field.GoName))
+	case "DateTime":
+		generateDateTimeOption(g, message, field, optionName)
+		return
+	case "Interval":
+		g.P(fmt.Sprintf("// %s sets the %s field to the interval from start, inclusive, to end, exclusive.", optionName, field.GoName))
+	case "Decimal":
+		g.P(fmt.Sprintf("// %s sets the %s field to the shortest decimal representation of v.", optionName, field.GoName))
+	default:
+		g.P(fmt.Sprintf("// %s sets the %s field with a new instance.", optionName, field.GoName))
+	}
+	param, value := googleTypeValue(g, field.Message)
+	g.P(fmt.Sprintf("func %s(%s) %s {", optionName, param, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
+	g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
+	g.P("\t\t", fieldAssignment(message, field, value))
+	g.P("\t}")
+	g.P("}")
+	g.P()
+
+	if googleType(field.Message) == "Money" {
+		generateMoneyFromStringOption(g, message, field, optionName+"FromString")
+	}
+}
+
+// generateMoneyFromStringOption generates the option setting a google.type.Money field from a decimal amount.
+// Parsing the amount can fail, so it is parsed when the option is created and the error is returned with it.
+func generateMoneyFromStringOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, optionName string) {
+	errorf := g.QualifiedGoIdent(fmtPackage.Ident("Errorf"))
+	g.P(fmt.Sprintf("// %s sets the %s field to the decimal amount in the currency, amounts with more than nine decimal places are rejected.", optionName, field.GoName))
+	g.P(fmt.Sprintf("func %s(currencyCode, amount string) (%s, error) {", optionName, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
+	g.P(fmt.Sprintf("\tr, ok := new(%s).SetString(amount)", g.QualifiedGoIdent(bigPackage.Ident("Rat"))))
+	g.P("\tif !ok {")
+	g.P(fmt.Sprintf("\t\treturn nil, %s(\"%s: invalid amount %%q\", amount)", errorf, optionName))
+	g.P("\t}")
+	g.P(fmt.Sprintf("\tnanos := r.Mul(r, %s(1e9, 1))", g.QualifiedGoIdent(bigPackage.Ident("NewRat"))))
+	g.P("\tif !nanos.IsInt() {")
+	g.P(fmt.Sprintf("\t\treturn nil, %s(\"%s: amount %%q has more than nine decimal places\", amount)", errorf, optionName))
+	g.P("\t}")
+	bigInt := g.QualifiedGoIdent(bigPackage.Ident("Int"))
+	g.P(fmt.Sprintf("\tunits, rem := new(%s).QuoRem(nanos.Num(), %s(1e9), new(%s))", bigInt, g.QualifiedGoIdent(bigPackage.Ident("NewInt")), bigInt))
+	g.P("\tif !units.IsInt64() {")
+	g.P(fmt.Sprintf("\t\treturn nil, %s(\"%s: amount %%q is out of range\", amount)", errorf, optionName))
+	g.P("\t}")
+	g.P(fmt.Sprintf("\tvalue := &%s{CurrencyCode: currencyCode, Units: units.Int64(), Nanos: int32(rem.Int64())}", g.QualifiedGoIdent(field.Message.GoIdent)))
+	g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
+	g.P("\t\t", fieldAssignment(message, field, "value"))
+	g.P("\t}, nil")
+	g.P("}")
+	g.P()
+}
+
+// generateDateTimeOption generates the option setting a google.type.DateTime field from a time and location.
+func generateDateTimeOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, optionName string) {
+	g.P(fmt.Sprintf("// %s sets the %s field to v in loc, stored with the time zone of loc.", optionName, field.GoName))
+	g.P("// A nil loc stores v with its UTC offset instead, as does a loc without an IANA time zone name like time.Local or a fixed zone.")
+	g.P(fmt.Sprintf("func %s(v %s, loc *%s) %s {", optionName, g.QualifiedGoIdent(timePackage.Ident("Time")), g.QualifiedGoIdent(timePackage.Ident("Location")), qualifiedIdentForName(g, message.GoIdent, "", "Option")))
+	g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
+	g.P("\t\tt := v")
+	g.P("\t\tif loc != nil {")
+	g.P("\t\t\tt = v.In(loc)")
+	g.P("\t\t}")
+	generateDateTimeValue(g, field)
+	g.P("\t\t", fieldAssignment(message, field, "value"))
+	g.P("\t}")
+	g.P("}")
+	g.P()
+}
+
+// generateDateTimeValue generates the statements building the google.type.DateTime value from the time t in the location loc.
+// The time zone is stored when the name of loc loads as an IANA time zone, the UTC offset of t otherwise.
+func generateDateTimeValue(g *protogen.GeneratedFile, field *protogen.Field) {
+	utcOffset := googleTypeField(field.Message, "utc_offset")
+	timeZone := googleTypeField(field.Message, "time_zone")
+	g.P(fmt.Sprintf("\t\tvalue := &%s{}", g.QualifiedGoIdent(field.Message.GoIdent)))
+	g.P("\t\tzone := \"\"")
+	g.P("\t\tif loc != nil && loc.String() != \"Local\" {")
+	g.P(fmt.Sprintf("\t\t\tif _, err := %s(loc.String()); err == nil {", g.QualifiedGoIdent(timePackage.Ident("LoadLocation"))))
+	g.P("\t\t\t\tzone = loc.String()")
+	g.P("\t\t\t}")
+	g.P("\t\t}")
+	g.P("\t\tif zone != \"\" {")
+	g.P(fmt.Sprintf("\t\t\tvalue.TimeOffset = &%s{TimeZone: &%s{Id: zone}}", g.QualifiedGoIdent(timeZone.GoIdent), g.QualifiedGoIdent(timeZone.Message.GoIdent)))
+	g.P("\t\t} else {")
+	g.P("\t\t\t_, offset := t.Zone()")
+	g.P(fmt.Sprintf("\t\t\tvalue.TimeOffset = &%s{UtcOffset: %s(%s(offset) * %s)}", g.QualifiedGoIdent(utcOffset.GoIdent),
+		g.QualifiedGoIdent(utcOffset.Message.GoIdent.GoImportPath.Ident("New")), g.QualifiedGoIdent(timePackage.Ident("Duration")), g.QualifiedGoIdent(timePackage.Ident("Second"))))
+	g.P("\t\t}")
+	g.P("\t\tvalue.Year, value.Month, value.Day = int32(t.Year()), int32(t.Month()), int32(t.Day())")
+	g.P("\t\tvalue.Hours, value.Minutes, value.Seconds, value.Nanos = int32(t.Hour()), int32(t.Minute()), int32(t.Second()), int32(t.Nanosecond())")
+}
+
+// qualifiedIdentForName takes the protogen.GoIdent and will return a qualified Go identifier with the given prefix and suffix
+// examples: prefix "New" for GoIdent "Foo" in an external package "foo" will return "foo.NewFoo"
+// example: suffix "Option" for GoIdent "Bar" in the same package will return "BarOption"
+func qualifiedIdentForName(g *protogen.GeneratedFile, ident protogen.GoIdent, prefix string, suffix string) string {
+	log(g, "qualifying identifier for name: ", ident.GoName)
+	return g.QualifiedGoIdent(ident.GoImportPath.Ident(prefix + ident.GoName + suffix))
+}
+
+func generateDirectNestedFieldOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, optionName string) {
+	log(g, "generating direct nested field option for message: ", message.GoIdent.GoName)
+	g.P(fmt.Sprintf("// %s sets the %s field directly.", optionName, field.GoName))
+	g.P(fmt.Sprintf("func %s(value *%s) %s {", optionName, g.QualifiedGoIdent(field.Message.GoIdent), qualifiedIdentForName(g, message.GoIdent, "", "Option")))
+	g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
+	g.P("\t\t", fieldAssignment(message, field, "value"))
+	g.P("\t}")
+	g.P("}")
+	g.P()
+}
+
+func generateScalarFieldOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, optionName string) {
+	log(g, "generating scalar field option for message: ", message.GoIdent.GoName)
+	fieldType := determineFieldType(g, field)
+	g.P(fmt.Sprintf("// %s sets the %s field.", optionName, field.GoName))
+	if field.Desc.IsList() {
+		log(g, "field is a list")
+		g.P(fmt.Sprintf("func %s(value ...%s) %s {", optionName, fieldType, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
+	} else {
+		log(g, "field is not a list")
+		g.P(fmt.Sprintf("func %s(value %s) %s {", optionName, fieldType, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
+	}
+	g.P(fmt.Sprintf("\treturn func(m *%s) {", g.QualifiedGoIdent(message.GoIdent)))
+	if field.Desc.IsList() || usesSetters(message) {
+		log(g, "field is a list or set through the setter")
+		g.P("\t\t", fieldAssignment(message, field, "value"))
+	} else if !field.Desc.HasPresence() {
+		// implicit presence (proto3 or editions IMPLICIT) fields are stored as plain values
+		log(g, "field has no presence")
+		g.P(fmt.Sprintf("\t\tm.%s = value", field.GoName))
+	} else if protoHelperFunc(field.Desc.Kind()) != "" {
+		log(g, "field is a scalar")
+		g.P("\t\tm.", field.GoName, " = ", protoPackage.Ident(protoHelperFunc(field.Desc.Kind())), "(value)")
+	} else if field.Desc.Kind() == protoreflect.EnumKind {
+		log(g, "field is an enum")
+		g.P(fmt.Sprintf("\t\tm.%s = value.Enum()", field.GoName))
+	} else {
+		log(g, "field is an interface")
+		g.P(fmt.Sprintf("\t\tm.%s = value", field.GoName))
+	}
+	g.P("\t}")
+	g.P("}")
+	g.P()
+}
+
+// generateEnumValueOptions generates an option without arguments for every non-zero value of the enum field,
+// WithStatusActive() sets the Status field to ACTIVE.
+// enumOptionValues returns the enum values generateEnumValueOptions generates an option for, every value but the zero value
+// of a singular enum field outside a oneof.
+func enumOptionValues(field *protogen.Field) []*protogen.EnumValue {
+	if field.Enum == nil || field.Desc.IsList() || (field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()) || optionFlagForField(field, GO_OPTIONS_SKIP) {
+		return nil
+	}
+	var values []*protogen.EnumValue
+	for _, value := range field.Enum.Values {
+		if value.Desc.Number() != 0 {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func generateEnumValueOptions(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, names optionNames) {
+	log(g, "generating enum value options for field: ", field.GoName)
+	for _, value := range enumOptionValues(field) {
+		optionName := fieldOptionName(*optionPrefix, message, field, enumValueSuffix(field.Enum, value), names)
+		g.P(fmt.Sprintf("// %s sets the %s field to %s.", optionName, field.GoName, value.Desc.Name()))
+		g.P(fmt.Sprintf("func %s() %s {", optionName, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
+		g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
+		if usesSetters(message) || !field.Desc.HasPresence() {
+			g.P("\t\t", fieldAssignment(message, field, g.QualifiedGoIdent(value.GoIdent)))
+		} else {
+			g.P("\t\t", fieldAssignment(message, field, g.QualifiedGoIdent(value.GoIdent)+".Enum()"))
+		}
+		g.P("\t}")
+		g.P("}")
+		g.P()
+	}
+}
+
+// enumValueSuffix returns the CamelCase name of the enum value without the enum name prefix,
+// PRIORITY_HIGH of enum Priority becomes High.
+func enumValueSuffix(enum *protogen.Enum, value *protogen.EnumValue) string {
+	name := string(value.Desc.Name())
+	prefix := upperSnakeCase(string(enum.Desc.Name())) + "_"
+	if strings.HasPrefix(name, prefix) && len(name) > len(prefix) {
+		name = strings.TrimPrefix(name, prefix)
+	}
+	var b strings.Builder
+	for _, part := range strings.Split(name, "_") {
+		if part == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]))
+		b.WriteString(strings.ToLower(part[1:]))
+	}
+	return b.String()
+}
+
+// upperSnakeCase converts a CamelCase name to UPPER_SNAKE_CASE, FooBar becomes FOO_BAR.
+func upperSnakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) && !unicode.IsUpper(rune(name[i-1])) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
+
+func generateRepeatedFieldOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, optionName string) {
+	log(g, "generating repeated field option for message: ", message.GoIdent.GoName)
+	elementType := determineFieldType(g, field)
+	g.P(fmt.Sprintf("// %s sets the %s field.", optionName, field.GoName))
+	g.P(fmt.Sprintf("func %s(values ...%s) %s {", optionName, elementType, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
+	g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
+	g.P("\t\t", fieldAssignment(message, field, "values"))
+	g.P("\t}")
+	g.P("}")
+	g.P()
+}
+
+// buildsFromOptions reports whether the message has an Option type and a constructor taking options,
+// messages without fields, with skip_init or without generated options have neither.
+func buildsFromOptions(message *protogen.Message) bool {
+	return message.Fields != nil && !optionFlagForMessage(message, GO_OPTIONS_SKIP_INIT) && generatesOptions(message)
+}
+
+// generateRepeatedFieldAddOption generates an option appending to the repeated field.
+// Elements of message fields are built from the options of the element message.
+func generateRepeatedFieldAddOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, optionName string) {
+	log(g, "generating repeated field add option for message: ", message.GoIdent.GoName)
+	if field.Desc.Kind() == protoreflect.MessageKind && buildsFromOptions(field.Message) {
+		g.P(fmt.Sprintf("// %s appends a new element built from the options to the %s field.", optionName, field.GoName))
+		g.P(fmt.Sprintf("func %s(opts ...%s) %s {", optionName, qualifiedIdentForName(g, field.Message.GoIdent, "", "Option"), qualifiedIdentForName(g, message.GoIdent, "", "Option")))
+		g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
+		g.P("\t\t", fieldAssignment(message, field, fmt.Sprintf("append(%s, %s(opts...))", fieldAccess(message, field), qualifiedIdentForName(g, field.Message.GoIdent, *constructorPrefix, ""))))
+	} else {
+		g.P(fmt.Sprintf("// %s appends the values to the %s field.", optionName, field.GoName))
+		g.P(fmt.Sprintf("func %s(values ...%s) %s {", optionName, determineFieldType(g, field), qualifiedIdentForName(g, message.GoIdent, "", "Option")))
+		g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
+		g.P("\t\t", fieldAssignment(message, field, fmt.Sprintf("append(%s, values...)", fieldAccess(message, field))))
+	}
+	g.P("\t}")
+	g.P("}")
+	g.P()
+}
+
+// generateTimeCollectionOption generates an option setting a repeated field or map values of a time message
+// from time package values, each converted into a new message.
+func generateTimeCollectionOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, optionName string) {
+	element := field
+	if field.Desc.IsMap() {
+		element = field.Message.Fields[1]
+	}
+	if element.Message == nil || !timeMessage(element.Message) {
+		return
+	}
+	log(g, "generating time collection option for message: ", message.GoIdent.GoName)
+	goType, value := timeConversion(g, element.Message)
+	elementType := "*" + g.QualifiedGoIdent(element.Message.GoIdent)
+	g.P(fmt.Sprintf("// %s sets the %s field with new instances converted from the values.", optionName, field.GoName))
+	if field.Desc.IsMap() {
+		keyType := determineFieldType(g, field.Message.Fields[0])
+		g.P(fmt.Sprintf("func %s(values map[%s]%s) %s {", optionName, keyType, goType, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
+		g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
+		g.P(fmt.Sprintf("\t\tconverted := make(map[%s]%s, len(values))", keyType, elementType))
+		g.P("\t\tfor k, v := range values {")
+		g.P("\t\t\tconverted[k] = ", value)
+	} else {
+		g.P(fmt.Sprintf("func %s(values ...%s) %s {", optionName, goType, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
+		g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
+		g.P(fmt.Sprintf("\t\tconverted := make([]%s, len(values))", elementType))
+		g.P("\t\tfor i, v := range values {")
+		g.P("\t\t\tconverted[i] = ", value)
+	}
+	g.P("\t\t}")
+	g.P("\t\t", fieldAssignment(message, field, "converted"))
+	g.P("\t}")
+	g.P("}")
+	g.P()
+}
+
+// generatesOptions reports whether options are expected to exist for the message,
+// the well-known types and the googleapis types, declared in the google proto packages, come without them.
+func generatesOptions(message *protogen.Message) bool {
+	pkg := message.Desc.ParentFile().Package()
+	return !wellKnownPath(message.GoIdent) && pkg != "google" && !strings.HasPrefix(string(pkg), "google.")
+}
+
+func generateMapFieldOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, optionName string) {
 	log(g, "generating map field option for message: ", message.GoIdent.GoName)
 	keyType := determineFieldType(g, field.Message.Fields[0])
 	valueType := determineFieldType(g, field.Message.Fields[1])
 	g.P(fmt.Sprintf("// %s sets the %s field.", optionName, field.GoName))
 	g.P(fmt.Sprintf("func %s(value map[%s]%s) %s {", optionName, keyType, valueType, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
 	g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
-	g.P(fmt.Sprintf("\t\tm.%s = value", field.GoName))
+	g.P("\t\t", fieldAssignment(message, field, "value"))
+	g.P("\t}")
+	g.P("}")
+	g.P()
+}
+
+// generateMapFieldPutOption generates an option setting a single entry of the map field, the map is allocated when nil.
+func generateMapFieldPutOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, optionName string) {
+	log(g, "generating map field put option for message: ", message.GoIdent.GoName)
+	keyType := determineFieldType(g, field.Message.Fields[0])
+	valueType := determineFieldType(g, field.Message.Fields[1])
+	g.P(fmt.Sprintf("// %s sets the entry for key in the %s field.", optionName, field.GoName))
+	g.P(fmt.Sprintf("func %s(key %s, value %s) %s {", optionName, keyType, valueType, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
+	g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
+	g.P(fmt.Sprintf("\t\tif %s == nil {", fieldAccess(message, field)))
+	g.P("\t\t\t", fieldAssignment(message, field, fmt.Sprintf("make(map[%s]%s)", keyType, valueType)))
+	g.P("\t\t}")
+	g.P(fmt.Sprintf("\t\t%s[key] = value", fieldAccess(message, field)))
+	g.P("\t}")
+	g.P("}")
+	g.P()
+}
+
+// generateMapFieldMergeOption generates an option copying all entries into the map field, the map is allocated when nil.
+func generateMapFieldMergeOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, optionName string) {
+	log(g, "generating map field merge option for message: ", message.GoIdent.GoName)
+	keyType := determineFieldType(g, field.Message.Fields[0])
+	valueType := determineFieldType(g, field.Message.Fields[1])
+	g.P(fmt.Sprintf("// %s copies the entries of value into the %s field, overwriting existing keys.", optionName, field.GoName))
+	g.P(fmt.Sprintf("func %s(value map[%s]%s) %s {", optionName, keyType, valueType, qualifiedIdentForName(g, message.GoIdent, "", "Option")))
+	g.P(fmt.Sprintf("\treturn func(m *%s) {", message.GoIdent.GoName))
+	g.P(fmt.Sprintf("\t\tif %s == nil {", fieldAccess(message, field)))
+	g.P("\t\t\t", fieldAssignment(message, field, fmt.Sprintf("make(map[%s]%s, len(value))", keyType, valueType)))
+	g.P("\t\t}")
+	g.P("\t\tfor k, v := range value {")
+	g.P(fmt.Sprintf("\t\t\t%s[k] = v", fieldAccess(message, field)))
+	g.P("\t\t}")
+	g.P("\t}")
+	g.P("}")
+	g.P()
+}
+
+// generatesErrorOptions reports whether the error returning options are generated for the message.
+// The (go_options.message) option takes precedence over the (go_options.file) option and the error_options parameter.
+func generatesErrorOptions(message *protogen.Message) bool {
+	if v, ok := extensionFlag(message.Desc.Options(), gooptions.E_Message, GO_OPTIONS_ERROR_OPTIONS); ok {
+		return v
+	}
+	if v, ok := extensionFlag(message.Desc.ParentFile().Options(), gooptions.E_File, GO_OPTIONS_ERROR_OPTIONS); ok {
+		return v
+	}
+	return *errorOptions
+}
+
+// generateErrorOptionsForMessage generates the <Message>OptionE type with its constructor and apply function,
+// which stop at the first option returning an error, and <Message>OptionsE turning regular options into one.
+func generateErrorOptionsForMessage(g *protogen.GeneratedFile, message *protogen.Message) {
+	messageName := message.GoIdent.GoName
+	optionType := qualifiedIdentForName(g, message.GoIdent, "", "OptionE")
+	log(g, "generating error options for message: ", messageName)
+
+	g.P(fmt.Sprintf("// %sOptionE defines a functional option for %s that can fail.", messageName, messageName))
+	g.P(fmt.Sprintf("type %sOptionE func(*%s) error", messageName, messageName))
+	g.P()
+
+	liftName := messageName + "OptionsE"
+	g.P(fmt.Sprintf("// %s turns the options into a single %sOptionE that never fails.", liftName, messageName))
+	g.P(fmt.Sprintf("func %s(opts ...%s) %s {", liftName, qualifiedIdentForName(g, message.GoIdent, "", "Option"), optionType))
+	g.P(fmt.Sprintf("\treturn func(m *%s) error {", messageName))
+	g.P("\t\tfor _, opt := range opts {")
+	g.P("\t\t\topt(m)")
+	g.P("\t\t}")
+	g.P("\t\treturn nil")
+	g.P("\t}")
+	g.P("}")
+	g.P()
+
+	if !optionFlagForMessage(message, GO_OPTIONS_SKIP_INIT) {
+		constructorName := fmt.Sprintf("%s%sE", *constructorPrefix, messageName)
+		g.P(fmt.Sprintf("// %s creates a new %s, it stops at the first option returning an error.", constructorName, messageName))
+		g.P(fmt.Sprintf("func %s(opts ...%s) (*%s, error) {", constructorName, optionType, messageName))
+		g.P(fmt.Sprintf("\tm := &%s{}", messageName))
+		g.P("\tfor _, opt := range opts {")
+		g.P("\t\tif err := opt(m); err != nil {")
+		g.P("\t\t\treturn nil, err")
+		g.P("\t\t}")
+		g.P("\t}")
+		g.P("\treturn m, nil")
+		g.P("}")
+		g.P()
+	}
+
+	if !optionFlagForMessage(message, GO_OPTIONS_OPTIONLESS) {
+		applyName := fmt.Sprintf("Apply%sOptionsE", messageName)
+		g.P(fmt.Sprintf("// %s applies the provided options to an existing %s, it stops at the first option returning an error.", applyName, messageName))
+		g.P("// The options before the failing one remain applied.")
+		g.P(fmt.Sprintf("func %s(m *%s, opts ...%s) (*%s, error) {", applyName, messageName, optionType, messageName))
+		g.P("\tfor _, opt := range opts {")
+		g.P("\t\tif err := opt(m); err != nil {")
+		g.P("\t\t\treturn m, err")
+		g.P("\t\t}")
+		g.P("\t}")
+		g.P("\treturn m, nil")
+		g.P("}")
+		g.P()
+	}
+}
+
+// generateFieldErrorOption generates the E option validating its input for fields where the input can be invalid:
+// enums outside the declared values, timestamps and durations outside the protobuf range, negative durations,
+// malformed field mask paths and nested messages built from their own E options.
+// Errors are prefixed with the option name.
+func generateFieldErrorOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, names optionNames) {
+	if !hasErrorOption(field) {
+		return
+	}
+	messageName := message.GoIdent.GoName
+	optionType := qualifiedIdentForName(g, message.GoIdent, "", "OptionE")
+
+	switch field.Desc.Kind() {
+	case protoreflect.EnumKind:
+		optionName := fieldOptionName(*optionPrefix, message, field, "E", names)
+		enumType := g.QualifiedGoIdent(field.Enum.GoIdent)
+		log(g, "generating enum error option for field: ", field.GoName)
+		g.P(fmt.Sprintf("// %s sets the %s field, values not declared in %s are rejected.", optionName, field.GoName, field.Enum.GoIdent.GoName))
+		g.P(fmt.Sprintf("func %s(value %s) %s {", optionName, enumType, optionType))
+		g.P(fmt.Sprintf("\treturn func(m *%s) error {", messageName))
+		g.P("\t\tif value.Descriptor().Values().ByNumber(value.Number()) == nil {")
+		g.P("\t\t\treturn ", fmtPackage.Ident("Errorf"), fmt.Sprintf("(\"%s: %%d is not a valid %s\", value)", optionName, field.Enum.GoIdent.GoName))
+		g.P("\t\t}")
+		if usesSetters(message) || !field.Desc.HasPresence() {
+			g.P("\t\t", fieldAssignment(message, field, "value"))
+		} else {
+			g.P("\t\t", fieldAssignment(message, field, "value.Enum()"))
+		}
+		g.P("\t\treturn nil")
+		g.P("\t}")
+		g.P("}")
+		g.P()
+	case protoreflect.MessageKind:
+		ident := field.Message.GoIdent
+		if googleType(field.Message) != "" {
+			generateGoogleTypeErrorOption(g, message, field, fieldOptionName(*optionPrefix, message, field, "E", names))
+			return
+		}
+		if wellKnownPath(ident) {
+			generateWellKnownErrorOption(g, message, field, fieldOptionName(*optionPrefix, message, field, "E", names))
+			return
+		}
+		optionName := fmt.Sprintf("%sNew%sFor%sE", *optionPrefix, field.GoName, messageName)
+		log(g, "generating nested error option for field: ", field.GoName)
+		g.P(fmt.Sprintf("// %s sets the %s field with a new instance, built with its E options.", optionName, field.GoName))
+		g.P(fmt.Sprintf("func %s(opts ...%s) %s {", optionName, qualifiedIdentForName(g, ident, "", "OptionE"), optionType))
+		g.P(fmt.Sprintf("\treturn func(m *%s) error {", messageName))
+		g.P(fmt.Sprintf("\t\tvalue, err := %s(&%s{}, opts...)", qualifiedIdentForName(g, ident, "Apply", "OptionsE"), g.QualifiedGoIdent(ident)))
+		g.P("\t\tif err != nil {")
+		g.P("\t\t\treturn ", fmtPackage.Ident("Errorf"), fmt.Sprintf("(\"%s: %%w\", err)", optionName))
+		g.P("\t\t}")
+		g.P("\t\t", fieldAssignment(message, field, "value"))
+		g.P("\t\treturn nil")
+		g.P("\t}")
+		g.P("}")
+		g.P()
+	}
+}
+
+// hasErrorOption reports whether generateFieldErrorOption generates an option for the field.
+func hasErrorOption(field *protogen.Field) bool {
+	if field.Desc.IsList() || field.Desc.IsMap() || (field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()) {
+		return false
+	}
+	switch field.Desc.Kind() {
+	case protoreflect.EnumKind:
+		return true
+	case protoreflect.MessageKind:
+		ident := field.Message.GoIdent
+		if t := googleType(field.Message); t != "" {
+			return !*disableWkt && (t == "Date" || t == "Money" || t == "LatLng" || t == "DateTime" || t == "Interval" || t == "Decimal")
+		}
+		if wellKnownPath(ident) {
+			return !*disableWkt && (ident.GoName == "Timestamp" || ident.GoName == "Duration" || ident.GoName == "FieldMask")
+		}
+		return generatesOptions(field.Message) && generatesErrorOptions(field.Message) && !optionFlagForMessage(field.Message, GO_OPTIONS_OPTIONLESS)
+	}
+	return false
+}
+
+// generateWellKnownErrorOption generates the E option of a Timestamp, Duration or FieldMask field.
+func generateWellKnownErrorOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, optionName string) {
+	messageName := message.GoIdent.GoName
+	optionType := qualifiedIdentForName(g, message.GoIdent, "", "OptionE")
+	ident := field.Message.GoIdent
+
+	switch ident.GoName {
+	case "Timestamp":
+		log(g, "generating timestamp error option for field: ", field.GoName)
+		g.P(fmt.Sprintf("// %s sets the %s field, times outside the range of a Timestamp are rejected.", optionName, field.GoName))
+		g.P(fmt.Sprintf("func %s(v %s) %s {", optionName, g.QualifiedGoIdent(timePackage.Ident("Time")), optionType))
+		g.P(fmt.Sprintf("\treturn func(m *%s) error {", messageName))
+		g.P(fmt.Sprintf("\t\tvalue := %s(v)", g.QualifiedGoIdent(ident.GoImportPath.Ident("New"))))
+	case "Duration":
+		log(g, "generating duration error option for field: ", field.GoName)
+		g.P(fmt.Sprintf("// %s sets the %s field, negative durations are rejected.", optionName, field.GoName))
+		g.P(fmt.Sprintf("func %s(v %s) %s {", optionName, g.QualifiedGoIdent(timePackage.Ident("Duration")), optionType))
+		g.P(fmt.Sprintf("\treturn func(m *%s) error {", messageName))
+		g.P("\t\tif v < 0 {")
+		g.P("\t\t\treturn ", fmtPackage.Ident("Errorf"), fmt.Sprintf("(\"%s: negative duration %%s\", v)", optionName))
+		g.P("\t\t}")
+		g.P(fmt.Sprintf("\t\tvalue := %s(v)", g.QualifiedGoIdent(ident.GoImportPath.Ident("New"))))
+	case "FieldMask":
+		log(g, "generating field mask error option for field: ", field.GoName)
+		g.P(fmt.Sprintf("// %s sets the %s field, paths that are not a dot separated list of field names are rejected.", optionName, field.GoName))
+		g.P(fmt.Sprintf("func %s(paths ...string) %s {", optionName, optionType))
+		g.P(fmt.Sprintf("\treturn func(m *%s) error {", messageName))
+		g.P("\t\tfor _, path := range paths {")
+		g.P(fmt.Sprintf("\t\t\tif !%s(path).IsValid() {", g.QualifiedGoIdent(protoreflectPackage.Ident("FullName"))))
+		g.P("\t\t\t\treturn ", fmtPackage.Ident("Errorf"), fmt.Sprintf("(\"%s: malformed path %%q\", path)", optionName))
+		g.P("\t\t\t}")
+		g.P("\t\t}")
+		g.P(fmt.Sprintf("\t\tvalue := &%s{Paths: paths}", g.QualifiedGoIdent(ident)))
+	}
+	if ident.GoName != "FieldMask" {
+		g.P("\t\tif err := value.CheckValid(); err != nil {")
+		g.P("\t\t\treturn ", fmtPackage.Ident("Errorf"), fmt.Sprintf("(\"%s: %%w\", err)", optionName))
+		g.P("\t\t}")
+	}
+	g.P("\t\t", fieldAssignment(message, field, "value"))
+	g.P("\t\treturn nil")
+	g.P("\t}")
+	g.P("}")
+	g.P()
+}
+
+// generateGoogleTypeErrorOption generates the E option of a google.type field,
+// rejecting the values outside the ranges documented by the type.
+func generateGoogleTypeErrorOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, optionName string) {
+	errorf := g.QualifiedGoIdent(fmtPackage.Ident("Errorf"))
+	log(g, "generating googleapis type error option for field: ", field.GoName)
+	if googleType(field.Message) == "DateTime" {
+		generateDateTimeErrorOption(g, message, field, optionName)
+		return
+	}
+	param, value := googleTypeValue(g, field.Message)
+	switch googleType(field.Message) {
+	case "Date":
+		g.P(fmt.Sprintf("// %s sets the %s field to the date of v, years outside [1, 9999] are rejected.", optionName, field.GoName))
+	case "Money":
+		g.P(fmt.Sprintf("// %s sets the %s field, nanos outside (-1e9, 1e9) or with a sign other than units are rejected.", optionName, field.GoName))
+	case "LatLng":
+		g.P(fmt.Sprintf("// %s sets the %s field, latitudes outside [-90, 90] and longitudes outside [-180, 180] are rejected.", optionName, field.GoName))
+	case "Interval":
+		g.P(fmt.Sprintf("// %s sets the %s field, an end before the start is rejected.", optionName, field.GoName))
+	case "Decimal":
+		g.P(fmt.Sprintf("// %s sets the %s field, infinite values are rejected.", optionName, field.GoName))
+	}
+	g.P(fmt.Sprintf("func %s(%s) %s {", optionName, param, qualifiedIdentForName(g, message.GoIdent, "", "OptionE")))
+	g.P(fmt.Sprintf("\treturn func(m *%s) error {", message.GoIdent.GoName))
+	switch googleType(field.Message) {
+	case "Date":
+		g.P("\t\tif v.Year() < 1 || v.Year() > 9999 {")
+		g.P(fmt.Sprintf("\t\t\treturn %s(\"%s: year %%d out of range\", v.Year())", errorf, optionName))
+		g.P("\t\t}")
+	case "Money":
+		g.P("\t\tif nanos <= -1e9 || nanos >= 1e9 {")
+		g.P(fmt.Sprintf("\t\t\treturn %s(\"%s: nanos %%d out of range\", nanos)", errorf, optionName))
+		g.P("\t\t}")
+		g.P("\t\tif (units > 0 && nanos < 0) || (units < 0 && nanos > 0) {")
+		g.P(fmt.Sprintf("\t\t\treturn %s(\"%s: units %%d and nanos %%d have different signs\", units, nanos)", errorf, optionName))
+		g.P("\t\t}")
+	case "LatLng":
+		g.P("\t\tif !(latitude >= -90 && latitude <= 90) {")
+		g.P(fmt.Sprintf("\t\t\treturn %s(\"%s: latitude %%v out of range\", latitude)", errorf, optionName))
+		g.P("\t\t}")
+		g.P("\t\tif !(longitude >= -180 && longitude <= 180) {")
+		g.P(fmt.Sprintf("\t\t\treturn %s(\"%s: longitude %%v out of range\", longitude)", errorf, optionName))
+		g.P("\t\t}")
+	case "Interval":
+		g.P("\t\tif end.Before(start) {")
+		g.P(fmt.Sprintf("\t\t\treturn %s(\"%s: end %%s before start %%s\", end, start)", errorf, optionName))
+		g.P("\t\t}")
+	case "Decimal":
+		g.P("\t\tif v.IsInf() {")
+		g.P(fmt.Sprintf("\t\t\treturn %s(\"%s: infinite value %%v\", v)", errorf, optionName))
+		g.P("\t\t}")
+	}
+	g.P("\t\t", fieldAssignment(message, field, value))
+	g.P("\t\treturn nil")
+	g.P("\t}")
+	g.P("}")
+	g.P()
+}
+
+// generateDateTimeErrorOption generates the E option of a google.type.DateTime field, rejecting the years the type cannot hold.
+func generateDateTimeErrorOption(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, optionName string) {
+	g.P(fmt.Sprintf("// %s sets the %s field to v in loc, years outside [0, 9999] are rejected where 0 is a date and time without a year.", optionName, field.GoName))
+	g.P(fmt.Sprintf("func %s(v %s, loc *%s) %s {", optionName, g.QualifiedGoIdent(timePackage.Ident("Time")), g.QualifiedGoIdent(timePackage.Ident("Location")), qualifiedIdentForName(g, message.GoIdent, "", "OptionE")))
+	g.P(fmt.Sprintf("\treturn func(m *%s) error {", message.GoIdent.GoName))
+	g.P("\t\tt := v")
+	g.P("\t\tif loc != nil {")
+	g.P("\t\t\tt = v.In(loc)")
+	g.P("\t\t}")
+	g.P("\t\tif t.Year() < 0 || t.Year() > 9999 {")
+	g.P(fmt.Sprintf("\t\t\treturn %s(\"%s: year %%d out of range\", t.Year())", g.QualifiedGoIdent(fmtPackage.Ident("Errorf")), optionName))
+	g.P("\t\t}")
+	generateDateTimeValue(g, field)
+	g.P("\t\t", fieldAssignment(message, field, "value"))
+	g.P("\t\treturn nil")
+	g.P("\t}")
+	g.P("}")
+	g.P()
+}
+
+// bufValidateRules returns the rules set through the buf.validate extension with the given name in the options,
+// or nil when they are not set. The extension is looked up in the imports of the file and read dynamically,
+// so the plugin does not depend on the protovalidate Go module.
+func bufValidateRules(options proto.Message, file protoreflect.FileDescriptor, name protoreflect.FullName) protoreflect.Message {
+	xd := findExtension(file, name, make(map[string]bool))
+	if xd == nil {
+		return nil
+	}
+	xt := dynamicpb.NewExtensionType(xd)
+	resolver := new(protoregistry.Types)
+	if err := resolver.RegisterExtension(xt); err != nil {
+		return nil
+	}
+	// the extension is an unknown field of the options, as its Go type is not linked into the plugin
+	b, err := proto.Marshal(options)
+	if err != nil {
+		return nil
+	}
+	opts := options.ProtoReflect().New()
+	if err := (proto.UnmarshalOptions{Resolver: resolver}).Unmarshal(b, opts.Interface()); err != nil {
+		return nil
+	}
+	// the extendee is declared by the descriptor.proto of the request, so the extension is matched by name
+	var rules protoreflect.Message
+	opts.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.IsExtension() && fd.FullName() == name {
+			rules = v.Message()
+			return false
+		}
+		return true
+	})
+	return rules
+}
+
+// findExtension looks up the extension in the file and its transitive imports.
+func findExtension(file protoreflect.FileDescriptor, name protoreflect.FullName, seen map[string]bool) protoreflect.ExtensionDescriptor {
+	if seen[file.Path()] {
+		return nil
+	}
+	seen[file.Path()] = true
+	if xd := file.Extensions().ByName(name.Name()); xd != nil && xd.FullName() == name {
+		return xd
+	}
+	for i := 0; i < file.Imports().Len(); i++ {
+		if xd := findExtension(file.Imports().Get(i).FileDescriptor, name, seen); xd != nil {
+			return xd
+		}
+	}
+	return nil
+}
+
+// fieldValidateRules returns the (buf.validate.field) rules of the field.
+func fieldValidateRules(field *protogen.Field) protoreflect.Message {
+	return bufValidateRules(field.Desc.Options(), field.Desc.ParentFile(), "buf.validate.field")
+}
+
+// hasValidateRules reports whether the message, one of its fields or oneofs carries buf.validate rules.
+func hasValidateRules(message *protogen.Message) bool {
+	file := message.Desc.ParentFile()
+	if bufValidateRules(message.Desc.Options(), file, "buf.validate.message") != nil {
+		return true
+	}
+	for _, field := range message.Fields {
+		if fieldValidateRules(field) != nil {
+			return true
+		}
+	}
+	for _, oneof := range message.Oneofs {
+		if bufValidateRules(oneof.Desc.Options(), file, "buf.validate.oneof") != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// ruleValue returns the named value of the rules and whether it is set.
+func ruleValue(rules protoreflect.Message, name protoreflect.Name) (protoreflect.Value, bool) {
+	if rules == nil {
+		return protoreflect.Value{}, false
+	}
+	fd := rules.Descriptor().Fields().ByName(name)
+	if fd == nil || !rules.Has(fd) {
+		return protoreflect.Value{}, false
+	}
+	return rules.Get(fd), true
+}
+
+// validateCheck is a single rule checked by the generated Validate function.
+type validateCheck struct {
+	// field whose getter is bound to v in the condition, nil for checks on the message
+	field *protogen.Field
+	// guard only checks the rule when the field is set, as protovalidate ignores unset fields with presence
+	guard bool
+	// cond is the Go condition failing the rule
+	cond string
+	// msg is the error, prefixed with the field name
+	msg string
+	// nested validates the message or messages in the field with their own Validate function
+	nested bool
+}
+
+// validateChecks returns the rules of the message that are checked in generated code.
+// Rules written in CEL, patterns and well-known formats are left to the validate_func parameter.
+func validateChecks(g *protogen.GeneratedFile, message *protogen.Message) []validateCheck {
+	var checks []validateCheck
+	file := message.Desc.ParentFile()
+	for _, field := range message.Fields {
+		name := string(field.Desc.Name())
+		rules := fieldValidateRules(field)
+		if v, ok := ruleValue(rules, "required"); ok && v.Bool() {
+			checks = append(checks, validateCheck{
+				cond: fmt.Sprintf("!r.Has(r.Descriptor().Fields().ByName(%q))", name),
+				msg:  name + ": value is required",
+			})
+		}
+		for _, c := range fieldRuleChecks(g, field, rules) {
+			c.field = field
+			c.guard = field.Desc.HasPresence() && !field.Desc.IsList() && field.Desc.Kind() != protoreflect.MessageKind
+			c.msg = name + ": " + c.msg
+			checks = append(checks, c)
+		}
+		if field.Desc.Kind() == protoreflect.MessageKind && !field.Desc.IsMap() && generatesOptions(field.Message) && hasValidateRules(field.Message) {
+			checks = append(checks, validateCheck{field: field, nested: true, msg: name})
+		}
+	}
+	for _, oneof := range message.Oneofs {
+		rules := bufValidateRules(oneof.Desc.Options(), file, "buf.validate.oneof")
+		if v, ok := ruleValue(rules, "required"); ok && v.Bool() {
+			checks = append(checks, validateCheck{
+				cond: fmt.Sprintf("r.WhichOneof(r.Descriptor().Oneofs().ByName(%q)) == nil", oneof.Desc.Name()),
+				msg:  string(oneof.Desc.Name()) + ": exactly one field is required in oneof",
+			})
+		}
+	}
+	return checks
+}
+
+// fieldRuleChecks returns the checks for the type specific rules of the field, the conditions test v.
+// Functions in the conditions are qualified through g when the rule is present, so only used packages are imported.
+func fieldRuleChecks(g *protogen.GeneratedFile, field *protogen.Field, rules protoreflect.Message) []validateCheck {
+	var checks []validateCheck
+	add := func(cond, msg string) {
+		checks = append(checks, validateCheck{cond: cond, msg: msg})
+	}
+	kind := field.Desc.Kind()
+	switch {
+	case field.Desc.IsMap():
+		sub, _ := ruleValue(rules, "map")
+		mapRules := messageValue(sub)
+		if v, ok := ruleValue(mapRules, "min_pairs"); ok {
+			add(fmt.Sprintf("len(v) < %d", v.Uint()), fmt.Sprintf("map must be at least %d entries", v.Uint()))
+		}
+		if v, ok := ruleValue(mapRules, "max_pairs"); ok {
+			add(fmt.Sprintf("len(v) > %d", v.Uint()), fmt.Sprintf("map must be at most %d entries", v.Uint()))
+		}
+		return checks
+	case field.Desc.IsList():
+		sub, _ := ruleValue(rules, "repeated")
+		listRules := messageValue(sub)
+		if v, ok := ruleValue(listRules, "min_items"); ok {
+			add(fmt.Sprintf("len(v) < %d", v.Uint()), fmt.Sprintf("value must contain at least %d item(s)", v.Uint()))
+		}
+		if v, ok := ruleValue(listRules, "max_items"); ok {
+			add(fmt.Sprintf("len(v) > %d", v.Uint()), fmt.Sprintf("value must contain no more than %d item(s)", v.Uint()))
+		}
+		return checks
+	}
+
+	switch kind {
+	case protoreflect.StringKind:
+		sub, _ := ruleValue(rules, "string")
+		stringRules := messageValue(sub)
+		runes := func() string { return g.QualifiedGoIdent(utf8Package.Ident("RuneCountInString")) + "(v)" }
+		if v, ok := ruleValue(stringRules, "const"); ok {
+			add("v != "+strconv.Quote(v.String()), "value must equal "+strconv.Quote(v.String()))
+		}
+		if v, ok := ruleValue(stringRules, "len"); ok {
+			add(fmt.Sprintf("%s != %d", runes(), v.Uint()), fmt.Sprintf("value length must be %d characters", v.Uint()))
+		}
+		if v, ok := ruleValue(stringRules, "min_len"); ok {
+			add(fmt.Sprintf("%s < %d", runes(), v.Uint()), fmt.Sprintf("value length must be at least %d characters", v.Uint()))
+		}
+		if v, ok := ruleValue(stringRules, "max_len"); ok {
+			add(fmt.Sprintf("%s > %d", runes(), v.Uint()), fmt.Sprintf("value length must be at most %d characters", v.Uint()))
+		}
+		if v, ok := ruleValue(stringRules, "min_bytes"); ok {
+			add(fmt.Sprintf("len(v) < %d", v.Uint()), fmt.Sprintf("value length must be at least %d bytes", v.Uint()))
+		}
+		if v, ok := ruleValue(stringRules, "max_bytes"); ok {
+			add(fmt.Sprintf("len(v) > %d", v.Uint()), fmt.Sprintf("value length must be at most %d bytes", v.Uint()))
+		}
+		if v, ok := ruleValue(stringRules, "prefix"); ok {
+			add("!"+g.QualifiedGoIdent(stringsPackage.Ident("HasPrefix"))+"(v, "+strconv.Quote(v.String())+")", "value does not have prefix "+strconv.Quote(v.String()))
+		}
+		if v, ok := ruleValue(stringRules, "suffix"); ok {
+			add("!"+g.QualifiedGoIdent(stringsPackage.Ident("HasSuffix"))+"(v, "+strconv.Quote(v.String())+")", "value does not have suffix "+strconv.Quote(v.String()))
+		}
+		if v, ok := ruleValue(stringRules, "contains"); ok {
+			add("!"+g.QualifiedGoIdent(stringsPackage.Ident("Contains"))+"(v, "+strconv.Quote(v.String())+")", "value does not contain substring "+strconv.Quote(v.String()))
+		}
+		checks = append(checks, listChecks(stringRules, kind)...)
+	case protoreflect.BytesKind:
+		sub, _ := ruleValue(rules, "bytes")
+		bytesRules := messageValue(sub)
+		if v, ok := ruleValue(bytesRules, "len"); ok {
+			add(fmt.Sprintf("len(v) != %d", v.Uint()), fmt.Sprintf("value length must be %d bytes", v.Uint()))
+		}
+		if v, ok := ruleValue(bytesRules, "min_len"); ok {
+			add(fmt.Sprintf("len(v) < %d", v.Uint()), fmt.Sprintf("value length must be at least %d bytes", v.Uint()))
+		}
+		if v, ok := ruleValue(bytesRules, "max_len"); ok {
+			add(fmt.Sprintf("len(v) > %d", v.Uint()), fmt.Sprintf("value must be at most %d bytes", v.Uint()))
+		}
+	case protoreflect.BoolKind:
+		sub, _ := ruleValue(rules, "bool")
+		if v, ok := ruleValue(messageValue(sub), "const"); ok {
+			add(fmt.Sprintf("v != %t", v.Bool()), fmt.Sprintf("value must equal %t", v.Bool()))
+		}
+	case protoreflect.EnumKind:
+		sub, _ := ruleValue(rules, "enum")
+		enumRules := messageValue(sub)
+		if v, ok := ruleValue(enumRules, "const"); ok {
+			add(fmt.Sprintf("v != %d", v.Int()), fmt.Sprintf("value must equal %d", v.Int()))
+		}
+		if v, ok := ruleValue(enumRules, "defined_only"); ok && v.Bool() {
+			add("v.Descriptor().Values().ByNumber(v.Number()) == nil", "value must be one of the defined enum values")
+		}
+		checks = append(checks, listChecks(enumRules, kind)...)
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+	default:
+		sub, _ := ruleValue(rules, protoreflect.Name(kind.String()))
+		numberRules := messageValue(sub)
+		comparisons := []struct {
+			name protoreflect.Name
+			op   string
+			msg  string
+		}{
+			{"const", "!=", "value must equal"},
+			{"lt", ">=", "value must be less than"},
+			{"lte", ">", "value must be less than or equal to"},
+			{"gt", "<=", "value must be greater than"},
+			{"gte", "<", "value must be greater than or equal to"},
+		}
+		var upper, lower *validateCheck
+		var upperValue, lowerValue protoreflect.Value
+		for _, c := range comparisons {
+			v, ok := ruleValue(numberRules, c.name)
+			if !ok {
+				continue
+			}
+			literal := numberLiteral(kind, v)
+			check := validateCheck{cond: fmt.Sprintf("v %s %s", c.op, literal), msg: c.msg + " " + literal}
+			switch c.name {
+			case "lt", "lte":
+				upper, upperValue = &check, v
+			case "gt", "gte":
+				lower, lowerValue = &check, v
+			default:
+				checks = append(checks, check)
+			}
+		}
+		// a lower bound above the upper bound makes protovalidate reject the values between them instead
+		if upper != nil && lower != nil && numberLess(upperValue, lowerValue) {
+			add(lower.cond+" && "+upper.cond, lower.msg+" or "+strings.TrimPrefix(upper.msg, "value must be "))
+		} else {
+			for _, check := range []*validateCheck{upper, lower} {
+				if check != nil {
+					checks = append(checks, *check)
+				}
+			}
+		}
+		checks = append(checks, listChecks(numberRules, kind)...)
+	}
+	return checks
+}
+
+// numberLess reports whether the numeric rule value a is less than b, both holding the Go type of the same kind.
+func numberLess(a, b protoreflect.Value) bool {
+	switch a := a.Interface().(type) {
+	case int32:
+		return int64(a) < b.Int()
+	case int64:
+		return a < b.Int()
+	case uint32:
+		return uint64(a) < b.Uint()
+	case uint64:
+		return a < b.Uint()
+	case float32:
+		return float64(a) < b.Float()
+	default:
+		return a.(float64) < b.Float()
+	}
+}
+
+// listChecks returns the checks for the in and not_in rules.
+func listChecks(rules protoreflect.Message, kind protoreflect.Kind) []validateCheck {
+	var checks []validateCheck
+	for _, rule := range []struct {
+		name     protoreflect.Name
+		op, join string
+		msg      string
+	}{
+		{"in", "!=", " && ", "value must be in list"},
+		{"not_in", "==", " || ", "value must not be in list"},
+	} {
+		v, ok := ruleValue(rules, rule.name)
+		if !ok || v.List().Len() == 0 {
+			continue
+		}
+		var conds, literals []string
+		for i := 0; i < v.List().Len(); i++ {
+			literal := listLiteral(kind, v.List().Get(i))
+			conds = append(conds, fmt.Sprintf("v %s %s", rule.op, literal))
+			literals = append(literals, literal)
+		}
+		checks = append(checks, validateCheck{
+			cond: strings.Join(conds, rule.join),
+			msg:  fmt.Sprintf("%s [%s]", rule.msg, strings.Join(literals, ", ")),
+		})
+	}
+	return checks
+}
+
+// listLiteral returns the Go literal of an element of an in or not_in rule.
+func listLiteral(kind protoreflect.Kind, v protoreflect.Value) string {
+	switch kind {
+	case protoreflect.StringKind:
+		return strconv.Quote(v.String())
+	case protoreflect.EnumKind:
+		return strconv.FormatInt(v.Int(), 10)
+	}
+	return numberLiteral(kind, v)
+}
+
+// numberLiteral returns the Go literal of a numeric rule value.
+func numberLiteral(kind protoreflect.Kind, v protoreflect.Value) string {
+	switch kind {
+	case protoreflect.FloatKind:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 32)
+	case protoreflect.DoubleKind:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return strconv.FormatUint(v.Uint(), 10)
+	default:
+		return strconv.FormatInt(v.Int(), 10)
+	}
+}
+
+// messageValue returns the message of v, or nil when v is not set.
+func messageValue(v protoreflect.Value) protoreflect.Message {
+	if !v.IsValid() {
+		return nil
+	}
+	return v.Message()
+}
+
+// generateValidatedConstructor generates Validate<Message> checking the buf.validate rules of the message,
+// and New<Message>Validated running it after the options.
+func generateValidatedConstructor(g *protogen.GeneratedFile, message *protogen.Message) {
+	messageName := message.GoIdent.GoName
+	validateName := "Validate" + messageName
+	log(g, "generating validated constructor for message: ", messageName)
+
+	if !optionFlagForMessage(message, GO_OPTIONS_SKIP_INIT) {
+		constructorName := fmt.Sprintf("%s%sValidated", *constructorPrefix, messageName)
+		g.P(fmt.Sprintf("// %s creates a new %s and checks its buf.validate rules after the options are applied.", constructorName, messageName))
+		g.P(fmt.Sprintf("func %s(opts ...%s) (*%s, error) {", constructorName, qualifiedIdentForName(g, message.GoIdent, "", "Option"), messageName))
+		g.P(fmt.Sprintf("\tm := &%s{}", messageName))
+		g.P("\tfor _, opt := range opts {")
+		g.P("\t\topt(m)")
+		g.P("\t}")
+		g.P(fmt.Sprintf("\tif err := %s(m); err != nil {", validateName))
+		g.P("\t\treturn nil, err")
+		g.P("\t}")
+		if *validateFunc != "" {
+			i := strings.LastIndex(*validateFunc, ".")
+			hook := protogen.GoImportPath((*validateFunc)[:i]).Ident((*validateFunc)[i+1:])
+			g.P(fmt.Sprintf("\tif err := %s(m); err != nil {", g.QualifiedGoIdent(hook)))
+			g.P("\t\treturn nil, err")
+			g.P("\t}")
+		}
+		g.P("\treturn m, nil")
+		g.P("}")
+		g.P()
+	}
+
+	checks := validateChecks(g, message)
+	g.P(fmt.Sprintf("// %s checks the buf.validate rules of %s that do not need the protovalidate runtime,", validateName, messageName))
+	g.P("// the rules written in CEL are only checked by the validate_func parameter.")
+	g.P(fmt.Sprintf("func %s(m *%s) error {", validateName, messageName))
+	for _, c := range checks {
+		if c.guard || c.field == nil {
+			g.P("\tr := m.ProtoReflect()")
+			break
+		}
+	}
+	for _, c := range checks {
+		if c.nested {
+			getter, _ := c.field.MethodName("Get")
+			validate := qualifiedIdentForName(g, c.field.Message.GoIdent, "Validate", "")
+			if c.field.Desc.IsList() {
+				g.P(fmt.Sprintf("\tfor i, v := range m.%s() {", getter))
+				g.P(fmt.Sprintf("\t\tif err := %s(v); err != nil {", validate))
+				g.P("\t\t\treturn ", fmtPackage.Ident("Errorf"), fmt.Sprintf("(\"%s[%%d]: %%w\", i, err)", c.msg))
+			} else {
+				g.P(fmt.Sprintf("\tif v := m.%s(); v != nil {", getter))
+				g.P(fmt.Sprintf("\t\tif err := %s(v); err != nil {", validate))
+				g.P("\t\t\treturn ", fmtPackage.Ident("Errorf"), fmt.Sprintf("(\"%s: %%w\", err)", c.msg))
+			}
+			g.P("\t\t}")
+			g.P("\t}")
+			continue
+		}
+		cond := c.cond
+		if c.field == nil {
+			g.P(fmt.Sprintf("\tif %s {", cond))
+		} else {
+			getter, _ := c.field.MethodName("Get")
+			if c.guard {
+				if strings.Contains(cond, "&&") || strings.Contains(cond, "||") {
+					cond = "(" + cond + ")"
+				}
+				cond = fmt.Sprintf("r.Has(r.Descriptor().Fields().ByName(%q)) && %s", c.field.Desc.Name(), cond)
+			}
+			g.P(fmt.Sprintf("\tif v := m.%s(); %s {", getter, cond))
+		}
+		g.P("\t\treturn ", fmtPackage.Ident("Errorf"), fmt.Sprintf("(%s)", strconv.Quote(strings.ReplaceAll(c.msg, "%", "%%"))))
+		g.P("\t}")
+	}
+	g.P("\treturn nil")
+	g.P("}")
+	g.P()
+}
+
+// hasRequiredFields reports whether the message has required fields, proto2 required or editions LEGACY_REQUIRED,
+// directly or in the messages of its fields.
+func hasRequiredFields(message *protogen.Message) bool {
+	return requiresFields(message.Desc, make(map[protoreflect.FullName]bool))
+}
+
+func requiresFields(md protoreflect.MessageDescriptor, seen map[protoreflect.FullName]bool) bool {
+	if seen[md.FullName()] {
+		return false
+	}
+	seen[md.FullName()] = true
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		if fd.Cardinality() == protoreflect.Required {
+			return true
+		}
+		if fd.Message() != nil && requiresFields(fd.Message(), seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// generateCheckedConstructors generates Check<Message>Required returning an error for the first unset required field,
+// including those of the messages in its fields, and the New<Message>Checked and MustNew<Message> constructors running it.
+func generateCheckedConstructors(g *protogen.GeneratedFile, message *protogen.Message) {
+	messageName := message.GoIdent.GoName
+	checkName := fmt.Sprintf("Check%sRequired", messageName)
+	log(g, "generating checked constructors for message: ", messageName)
+
+	if !optionFlagForMessage(message, GO_OPTIONS_SKIP_INIT) {
+		optionType := qualifiedIdentForName(g, message.GoIdent, "", "Option")
+		checkedName := fmt.Sprintf("%s%sChecked", *constructorPrefix, messageName)
+		g.P(fmt.Sprintf("// %s creates a new %s and returns an error when a required field is unset after the options are applied.", checkedName, messageName))
+		g.P(fmt.Sprintf("func %s(opts ...%s) (*%s, error) {", checkedName, optionType, messageName))
+		g.P(fmt.Sprintf("\tm := &%s{}", messageName))
+		g.P("\tfor _, opt := range opts {")
+		g.P("\t\topt(m)")
+		g.P("\t}")
+		g.P(fmt.Sprintf("\tif err := %s(m); err != nil {", checkName))
+		g.P("\t\treturn nil, err")
+		g.P("\t}")
+		g.P("\treturn m, nil")
+		g.P("}")
+		g.P()
+
+		mustName := fmt.Sprintf("Must%s%s", *constructorPrefix, messageName)
+		g.P(fmt.Sprintf("// %s creates a new %s and panics when a required field is unset after the options are applied.", mustName, messageName))
+		g.P(fmt.Sprintf("func %s(opts ...%s) *%s {", mustName, optionType, messageName))
+		g.P(fmt.Sprintf("\tm, err := %s(opts...)", checkedName))
+		g.P("\tif err != nil {")
+		g.P("\t\tpanic(err)")
+		g.P("\t}")
+		g.P("\treturn m")
+		g.P("}")
+		g.P()
+	}
+
+	g.P(fmt.Sprintf("// %s returns an error when a required field of %s, or of a message set in one of its fields, is unset.", checkName, messageName))
+	g.P(fmt.Sprintf("func %s(m *%s) error {", checkName, messageName))
+	for _, field := range message.Fields {
+		if field.Desc.Cardinality() == protoreflect.Required {
+			g.P(fmt.Sprintf("\tif r := m.ProtoReflect(); !r.Has(r.Descriptor().Fields().ByName(%q)) {", field.Desc.Name()))
+			g.P("\t\treturn ", fmtPackage.Ident("Errorf"), fmt.Sprintf("(\"%s: required field is not set\")", field.Desc.Name()))
+			g.P("\t}")
+		}
+		if field.Message == nil {
+			continue
+		}
+		getter, _ := field.MethodName("Get")
+		switch {
+		case field.Desc.IsMap():
+			value := field.Message.Fields[1]
+			if value.Message == nil || !hasRequiredFields(value.Message) {
+				continue
+			}
+			g.P(fmt.Sprintf("\tfor k, v := range m.%s() {", getter))
+			g.P(fmt.Sprintf("\t\tif err := %s(v); err != nil {", checkRequiredFunc(g, value.Message)))
+			g.P("\t\t\treturn ", fmtPackage.Ident("Errorf"), fmt.Sprintf("(\"%s[%%v]: %%w\", k, err)", field.Desc.Name()))
+		case !hasRequiredFields(field.Message):
+			continue
+		case field.Desc.IsList():
+			g.P(fmt.Sprintf("\tfor i, v := range m.%s() {", getter))
+			g.P(fmt.Sprintf("\t\tif err := %s(v); err != nil {", checkRequiredFunc(g, field.Message)))
+			g.P("\t\t\treturn ", fmtPackage.Ident("Errorf"), fmt.Sprintf("(\"%s[%%d]: %%w\", i, err)", field.Desc.Name()))
+		default:
+			g.P(fmt.Sprintf("\tif v := m.%s(); v != nil {", getter))
+			g.P(fmt.Sprintf("\t\tif err := %s(v); err != nil {", checkRequiredFunc(g, field.Message)))
+			g.P("\t\t\treturn ", fmtPackage.Ident("Errorf"), fmt.Sprintf("(\"%s: %%w\", err)", field.Desc.Name()))
+		}
+		g.P("\t\t}")
+		g.P("\t}")
+	}
+	g.P("\treturn nil")
+	g.P("}")
+	g.P()
+}
+
+// checkRequiredFunc returns the function checking the required fields of a message field, Check<Message>Required
+// for messages with generated options and proto.CheckInitialized for the messages of other plugins.
+func checkRequiredFunc(g *protogen.GeneratedFile, message *protogen.Message) string {
+	if !generatesOptions(message) {
+		return g.QualifiedGoIdent(protoPackage.Ident("CheckInitialized"))
+	}
+	return qualifiedIdentForName(g, message.GoIdent, "Check", "Required")
+}
+
+// defaultsCollisionKey counts the messages generating a Defaults option, it is the GoName of a field
+// named defaults so the option and the options of such a field are renamed together.
+const defaultsCollisionKey = "Defaults"
+
+// defaultFields returns the fields with a declared default, proto2 [default = ...] or its editions equivalent.
+// Oneof members are left out as setting one would clear the others.
+func defaultFields(message *protogen.Message) []*protogen.Field {
+	var fields []*protogen.Field
+	for _, field := range message.Fields {
+		if !field.Desc.HasDefault() || (field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()) {
+			continue
+		}
+		if optionFlagForField(field, GO_OPTIONS_SKIP) {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// hasDefaults reports whether the message has fields with a declared default.
+func hasDefaults(message *protogen.Message) bool {
+	return message.Fields != nil && len(defaultFields(message)) != 0
+}
+
+// generateDefaultsOptions generates the Defaults option setting every field with a declared default,
+// so the defaults are stored in the message instead of only being returned by the getters,
+// and New<Message>WithDefaults applying it before the options.
+func generateDefaultsOptions(g *protogen.GeneratedFile, message *protogen.Message, names optionNames) {
+	messageName := message.GoIdent.GoName
+	optionType := qualifiedIdentForName(g, message.GoIdent, "", "Option")
+	optionName := *optionPrefix + defaultsCollisionKey
+	if names[defaultsNameKey(message)] {
+		optionName = fmt.Sprintf("%sFor%s", optionName, messageName)
+	}
+	log(g, "generating defaults options for message: ", messageName)
+
+	if !optionFlagForMessage(message, GO_OPTIONS_SKIP_INIT) {
+		constructorName := fmt.Sprintf("%s%sWithDefaults", *constructorPrefix, messageName)
+		g.P(fmt.Sprintf("// %s creates a new %s with the declared defaults set before the options are applied.", constructorName, messageName))
+		g.P(fmt.Sprintf("func %s(opts ...%s) *%s {", constructorName, optionType, messageName))
+		g.P(fmt.Sprintf("\tm := &%s{}", messageName))
+		g.P(fmt.Sprintf("\t%s()(m)", optionName))
+		g.P("\tfor _, opt := range opts {")
+		g.P("\t\topt(m)")
+		g.P("\t}")
+		g.P("\treturn m")
+		g.P("}")
+		g.P()
+	}
+
+	g.P(fmt.Sprintf("// %s sets every field of %s with a declared default to that value.", optionName, messageName))
+	g.P(fmt.Sprintf("func %s() %s {", optionName, optionType))
+	g.P(fmt.Sprintf("\treturn func(m *%s) {", messageName))
+	for _, field := range defaultFields(message) {
+		value := defaultLiteral(g, field)
+		switch {
+		case usesSetters(message) || field.Desc.Kind() == protoreflect.BytesKind:
+			g.P("\t\t", fieldAssignment(message, field, value))
+		case field.Desc.Kind() == protoreflect.EnumKind:
+			g.P(fmt.Sprintf("\t\tm.%s = %s.Enum()", field.GoName, value))
+		default:
+			g.P("\t\tm.", field.GoName, " = ", protoPackage.Ident(protoHelperFunc(field.Desc.Kind())), "(", value, ")")
+		}
+	}
+	g.P("\t}")
+	g.P("}")
+	g.P()
+}
+
+// defaultLiteral returns the Go expression of the declared default of the field.
+func defaultLiteral(g *protogen.GeneratedFile, field *protogen.Field) string {
+	v := field.Desc.Default()
+	switch kind := field.Desc.Kind(); kind {
+	case protoreflect.StringKind:
+		return strconv.Quote(v.String())
+	case protoreflect.BytesKind:
+		return fmt.Sprintf("[]byte(%q)", v.Bytes())
+	case protoreflect.BoolKind:
+		return strconv.FormatBool(v.Bool())
+	case protoreflect.EnumKind:
+		for _, value := range field.Enum.Values {
+			if value.Desc.Number() == v.Enum() {
+				return g.QualifiedGoIdent(value.GoIdent)
+			}
+		}
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		var special string
+		switch f := v.Float(); {
+		case math.IsInf(f, 1):
+			special = g.QualifiedGoIdent(mathPackage.Ident("Inf")) + "(1)"
+		case math.IsInf(f, -1):
+			special = g.QualifiedGoIdent(mathPackage.Ident("Inf")) + "(-1)"
+		case math.IsNaN(f):
+			special = g.QualifiedGoIdent(mathPackage.Ident("NaN")) + "()"
+		}
+		if special != "" && kind == protoreflect.FloatKind {
+			return "float32(" + special + ")"
+		}
+		if special != "" {
+			return special
+		}
+	}
+	return numberLiteral(field.Desc.Kind(), v)
+}
+
+// generatesBuilders reports whether the builder is generated for the message, the builders option
+// of the message takes precedence over the option of the file and the builders parameter.
+func generatesBuilders(message *protogen.Message) bool {
+	if v, ok := extensionFlag(message.Desc.Options(), gooptions.E_Message, GO_OPTIONS_BUILDERS); ok {
+		return v
+	}
+	if v, ok := extensionFlag(message.Desc.ParentFile().Options(), gooptions.E_File, GO_OPTIONS_BUILDERS); ok {
+		return v
+	}
+	return *builders
+}
+
+// builderMethodName returns the name of the builder method for the field,
+// fields named like the methods of every builder get a trailing underscore.
+func builderMethodName(field *protogen.Field) string {
+	switch field.GoName {
+	case "Apply", "Build", "Done":
+		return field.GoName + "_"
+	}
+	return field.GoName
+}
+
+// hasSubBuilder reports whether the builder of the message returns a builder for the message field.
+// A builder is generic over the builder Done returns to, so a field leading back to its own message
+// would need an infinite chain of instantiations; those fields are only set by value.
+func hasSubBuilder(message *protogen.Message, field *protogen.Field) bool {
+	if field.Message == nil || field.Desc.IsList() || field.Desc.IsMap() {
+		return false
+	}
+	if field.Message.Fields == nil || !generatesOptions(field.Message) || !generatesBuilders(field.Message) {
+		return false
+	}
+	// the sub-builder is created through its unexported fields, so it has to be in the same package
+	if field.Message.GoIdent.GoImportPath != message.GoIdent.GoImportPath {
+		return false
+	}
+	return !reaches(field.Message.Desc, message.Desc.FullName(), make(map[protoreflect.FullName]bool))
+}
+
+// reaches reports whether the message named target can be reached from md through message fields.
+func reaches(md protoreflect.MessageDescriptor, target protoreflect.FullName, seen map[protoreflect.FullName]bool) bool {
+	if md.FullName() == target {
+		return true
+	}
+	if seen[md.FullName()] {
+		return false
+	}
+	seen[md.FullName()] = true
+	for i := 0; i < md.Fields().Len(); i++ {
+		if fd := md.Fields().Get(i); fd.Message() != nil && reaches(fd.Message(), target, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// generateBuilder generates the <Message>Builder type building the message through chained methods,
+// each mirroring the option of a field, with builders for nested message fields returning to it with Done.
+func generateBuilder(g *protogen.GeneratedFile, message *protogen.Message, names optionNames) {
+	messageName := message.GoIdent.GoName
+	builderName := messageName + "Builder"
+	builderType := builderName + "[P]"
+	log(g, "generating builder for message: ", messageName)
+
+	g.P(fmt.Sprintf("// %s builds a %s through chained methods mirroring its options.", builderName, messageName))
+	constructorName := *constructorPrefix + builderName
+	g.P(fmt.Sprintf("// P is the type Done returns, the parent builder of a nested builder and struct{} for builders created by %s.", constructorName))
+	g.P(fmt.Sprintf("type %s[P any] struct {", builderName))
+	g.P(fmt.Sprintf("\tm      *%s", messageName))
+	g.P("\tparent P")
+	g.P("}")
+	g.P()
+
+	g.P(fmt.Sprintf("// %s returns a builder for a new %s.", constructorName, messageName))
+	g.P(fmt.Sprintf("func %s() *%s[struct{}] {", constructorName, builderName))
+	g.P(fmt.Sprintf("\treturn &%s[struct{}]{m: &%s{}}", builderName, messageName))
+	g.P("}")
+	g.P()
+
+	g.P("// Apply applies the options to the message being built.")
+	g.P(fmt.Sprintf("func (b *%s) Apply(opts ...%s) *%s {", builderType, qualifiedIdentForName(g, message.GoIdent, "", "Option"), builderType))
+	g.P("\tfor _, opt := range opts {")
+	g.P("\t\topt(b.m)")
 	g.P("\t}")
+	g.P("\treturn b")
 	g.P("}")
 	g.P()
+
+	g.P(fmt.Sprintf("// Build returns a copy of the %s built so far, the builder can be used further.", messageName))
+	g.P(fmt.Sprintf("func (b *%s) Build() *%s {", builderType, messageName))
+	g.P("\treturn ", protoPackage.Ident("Clone"), fmt.Sprintf("(b.m).(*%s)", messageName))
+	g.P("}")
+	g.P()
+
+	g.P("// Done returns the builder this builder was created from.")
+	g.P(fmt.Sprintf("func (b *%s) Done() P {", builderType))
+	g.P("\treturn b.parent")
+	g.P("}")
+	g.P()
+
+	for _, field := range message.Fields {
+		if optionFlagForField(field, GO_OPTIONS_SKIP) {
+			continue
+		}
+		if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() && optionFlagForOneof(field.Oneof, GO_OPTIONS_SKIP) {
+			continue
+		}
+		methodName := builderMethodName(field)
+		optionName := fieldOptionName(*optionPrefix, message, field, "", names)
+		if hasSubBuilder(message, field) {
+			getter, _ := field.MethodName("Get")
+			subBuilder := fmt.Sprintf("%s[*%s]", qualifiedIdentForName(g, field.Message.GoIdent, "", "Builder"), builderType)
+			g.P(fmt.Sprintf("// %s returns a builder for the %s field, Done returns to this builder.", methodName, field.GoName))
+			g.P("// The field is set to a new message first when it is unset.")
+			g.P(fmt.Sprintf("func (b *%s) %s() *%s {", builderType, methodName, subBuilder))
+			g.P(fmt.Sprintf("\tif b.m.%s() == nil {", getter))
+			g.P(fmt.Sprintf("\t\t%s(&%s{})(b.m)", optionName, g.QualifiedGoIdent(field.Message.GoIdent)))
+			g.P("\t}")
+			g.P(fmt.Sprintf("\treturn &%s{m: b.m.%s(), parent: b}", subBuilder, getter))
+			g.P("}")
+			g.P()
+			continue
+		}
+
+		var param string
+		switch {
+		case field.Desc.IsMap():
+			param = fmt.Sprintf("value map[%s]%s", determineFieldType(g, field.Message.Fields[0]), determineFieldType(g, field.Message.Fields[1]))
+		case field.Desc.IsList():
+			param = "values ..." + determineFieldType(g, field)
+		default:
+			param = "value " + determineFieldType(g, field)
+		}
+		g.P(fmt.Sprintf("// %s sets the %s field, see %s.", methodName, field.GoName, optionName))
+		g.P(fmt.Sprintf("func (b *%s) %s(%s) *%s {", builderType, methodName, param, builderType))
+		if field.Desc.IsList() && !field.Desc.IsMap() {
+			g.P(fmt.Sprintf("\t%s(values...)(b.m)", optionName))
+		} else {
+			g.P(fmt.Sprintf("\t%s(value)(b.m)", optionName))
+		}
+		g.P("\treturn b")
+		g.P("}")
+		g.P()
+	}
+}
+
+// usesSetters reports whether the message uses the Opaque or Hybrid API.
+// Fields of these messages are set through the generated Set methods instead of the struct fields.
+func usesSetters(message *protogen.Message) bool {
+	return message.APILevel == gofeaturespb.GoFeatures_API_OPAQUE || message.APILevel == gofeaturespb.GoFeatures_API_HYBRID
+}
+
+// fieldAssignment returns the statement setting the field of m to value.
+func fieldAssignment(message *protogen.Message, field *protogen.Field, value string) string {
+	return fieldAssignmentTo("m", message, field, value)
+}
+
+// fieldAssignmentTo returns the statement setting the field of the message in variable recv to value.
+// Members of a oneof are assigned through their wrapper struct.
+func fieldAssignmentTo(recv string, message *protogen.Message, field *protogen.Field, value string) string {
+	if usesSetters(message) {
+		setter, _ := field.MethodName("Set")
+		return fmt.Sprintf("%s.%s(%s)", recv, setter, value)
+	}
+	if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
+		return fmt.Sprintf("%s.%s = &%s{\n\t\t\t%s: %s,\n\t\t}", recv, field.Oneof.GoName, field.GoIdent.GoName, field.GoName, value)
+	}
+	return fmt.Sprintf("%s.%s = %s", recv, field.GoName, value)
+}
+
+// fieldAccess returns the expression reading the field of m.
+func fieldAccess(message *protogen.Message, field *protogen.Field) string {
+	return fieldAccessOf("m", message, field)
+}
+
+// fieldAccessOf returns the expression reading the field of the message in variable recv.
+// Members of a oneof are read through their getter.
+func fieldAccessOf(recv string, message *protogen.Message, field *protogen.Field) string {
+	if usesSetters(message) || (field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()) {
+		getter, _ := field.MethodName("Get")
+		return fmt.Sprintf("%s.%s()", recv, getter)
+	}
+	return recv + "." + field.GoName
+}
+
+// fieldGoType returns the Go type of the field as returned by its getter.
+func fieldGoType(g *protogen.GeneratedFile, field *protogen.Field) string {
+	switch {
+	case field.Desc.IsMap():
+		return fmt.Sprintf("map[%s]%s", determineFieldType(g, field.Message.Fields[0]), determineFieldType(g, field.Message.Fields[1]))
+	case field.Desc.IsList():
+		return "[]" + determineFieldType(g, field)
+	default:
+		return determineFieldType(g, field)
+	}
+}
+
+// jsonPersistent reports whether JSON persistence methods should be generated for the field.
+func jsonPersistent(field *protogen.Field) bool {
+	return !*disableJson && optionFlagForField(field, GO_OPTIONS_JSON_PERSISTENT)
+}
+
+// jsonOptions returns the JSON encoding settings of the field, the field custom option takes precedence
+// over the file custom option which takes precedence over the plugin parameters.
+func jsonOptions(field *protogen.Field) *gooptions.JsonOptions {
+	opts := &gooptions.JsonOptions{
+		Protojson:       proto.Bool(*useProtojson),
+		UseProtoNames:   proto.Bool(*jsonUseProtoNames),
+		EmitUnpopulated: proto.Bool(*jsonEmitUnpopulated),
+		DiscardUnknown:  proto.Bool(*jsonDiscardUnknown),
+	}
+	fileOpts, _ := proto.GetExtension(field.Desc.ParentFile().Options(), gooptions.E_File).(*gooptions.FileOptions)
+	fieldOpts, _ := proto.GetExtension(field.Desc.Options(), gooptions.E_Field).(*gooptions.FieldOptions)
+	// only the explicitly set values are merged
+	proto.Merge(opts, fileOpts.GetJson())
+	proto.Merge(opts, fieldOpts.GetJson())
+	return opts
+}
+
+// usesProtojson reports whether the JSON persistence methods of the field use protojson.
+func usesProtojson(field *protogen.Field) bool {
+	return jsonPersistent(field) && (jsonOptions(field).GetProtojson() || holdsOpaqueMessages(field))
+}
+
+// holdsOpaqueMessages reports whether the field holds messages of the Opaque or Hybrid API.
+// encoding/json only sees the internal state of these messages, so their fields always use protojson.
+func holdsOpaqueMessages(field *protogen.Field) bool {
+	value := field.Message
+	if field.Desc.IsMap() {
+		value = field.Message.Fields[1].Message
+	}
+	return value != nil && usesSetters(value)
 }
 
 func generateJsonMethods(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field) {
+	if usesProtojson(field) {
+		generateProtojsonMethods(g, message, field)
+		return
+	}
 	fieldName := field.GoName
 	messageName := message.GoIdent.GoName
 
@@ -453,17 +2487,289 @@ func generateJsonMethods(g *protogen.GeneratedFile, message *protogen.Message, f
 	log(g, "generating JSON methods for ", messageName, fieldName)
 	g.P(fmt.Sprintf("// Get%sAsJSON returns the %s field as a JSON byte slice.", fieldName, fieldName))
 	g.P("func (m *", messageName, ") Get", fieldName, "AsJSON() ([]byte, error) {")
-	g.P("out, err := json.Marshal(m.", fieldName, ")")
+	g.P("out, err := ", jsonPackage.Ident("Marshal"), "(", fieldAccess(message, field), ")")
 	g.P("if err != nil {")
-	g.P("return nil, fmt.Errorf(\"failed to marshal ", fieldName, " field: %w\", err)")
+	g.P("return nil, ", fmtPackage.Ident("Errorf"), "(\"failed to marshal ", fieldName, " field: %w\", err)")
 	g.P("}")
 	g.P("return out, nil")
 	g.P("}")
 	g.P()
 	g.P(fmt.Sprintf("// Set%sFromJSON sets the %s field from a JSON byte slice.", fieldName, fieldName))
 	g.P("func (m *", messageName, ") Set", fieldName, "FromJSON(v []byte) error {")
-	g.P("    return json.Unmarshal(v, &m.", fieldName, ")")
+	if usesSetters(message) {
+		g.P("var value ", fieldGoType(g, field))
+		g.P("if err := ", jsonPackage.Ident("Unmarshal"), "(v, &value); err != nil {")
+		g.P("return err")
+		g.P("}")
+		g.P(fieldAssignment(message, field, "value"))
+		g.P("return nil")
+	} else {
+		g.P("    return ", jsonPackage.Ident("Unmarshal"), "(v, &m.", fieldName, ")")
+	}
+	g.P("}")
+}
+
+// persistenceEncoding describes the Get<Field>As<Name> and Set<Field>From<Name> methods generated for a flag.
+type persistenceEncoding struct {
+	flag OptionFlag
+	name string
+	// pkg provides the Marshal and Unmarshal functions and their MarshalOptions and UnmarshalOptions
+	pkg protogen.GoImportPath
+	// base64 wraps the encoded message in a standard base64 string
+	base64 bool
+	doc    string
+}
+
+var persistenceEncodings = []persistenceEncoding{
+	{flag: GO_OPTIONS_BINARY_PERSISTENT, name: "Binary", pkg: protoPackage, doc: "the protobuf binary format"},
+	{flag: GO_OPTIONS_TEXT_PERSISTENT, name: "Text", pkg: prototextPackage, doc: "the protobuf text format"},
+	{flag: GO_OPTIONS_BASE64_PERSISTENT, name: "Base64", pkg: protoPackage, base64: true, doc: "base64 of the protobuf binary format"},
+}
+
+// generatePersistenceMethods generates the persistence methods of the encoding for the field.
+// Message fields are encoded directly, other fields are encoded as part of an otherwise empty message
+// which may lack its required fields.
+func generatePersistenceMethods(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field, encoding persistenceEncoding) {
+	fieldName := field.GoName
+	messageName := message.GoIdent.GoName
+	single := field.Desc.Kind() == protoreflect.MessageKind && !field.Desc.IsList() && !field.Desc.IsMap()
+	marshal, unmarshal := g.QualifiedGoIdent(encoding.pkg.Ident("Marshal")), g.QualifiedGoIdent(encoding.pkg.Ident("Unmarshal"))
+	if !single {
+		marshal = "(" + g.QualifiedGoIdent(encoding.pkg.Ident("MarshalOptions")) + "{AllowPartial: true}).Marshal"
+		unmarshal = "(" + g.QualifiedGoIdent(encoding.pkg.Ident("UnmarshalOptions")) + "{AllowPartial: true}).Unmarshal"
+	}
+	goType, zero := "[]byte", "nil"
+	if encoding.base64 {
+		goType, zero = "string", `""`
+	}
+
+	log(g, "generating ", encoding.name, " methods for ", messageName, fieldName)
+	g.P(fmt.Sprintf("// Get%sAs%s returns the %s field encoded in %s.", fieldName, encoding.name, fieldName, encoding.doc))
+	g.P("func (m *", messageName, ") Get", fieldName, "As", encoding.name, "() (", goType, ", error) {")
+	value := fieldAccess(message, field)
+	if !single {
+		g.P("holder := &", messageName, "{}")
+		g.P(fieldAssignmentTo("holder", message, field, fieldAccess(message, field)))
+		value = "holder"
+	}
+	g.P("out, err := ", marshal, "(", value, ")")
+	g.P("if err != nil {")
+	g.P("return ", zero, ", ", fmtPackage.Ident("Errorf"), "(\"failed to marshal ", fieldName, " field: %w\", err)")
+	g.P("}")
+	if encoding.base64 {
+		g.P("return ", g.QualifiedGoIdent(base64Package.Ident("StdEncoding")), ".EncodeToString(out), nil")
+	} else {
+		g.P("return out, nil")
+	}
+	g.P("}")
+	g.P()
+	g.P(fmt.Sprintf("// Set%sFrom%s sets the %s field from %s.", fieldName, encoding.name, fieldName, encoding.doc))
+	g.P("func (m *", messageName, ") Set", fieldName, "From", encoding.name, "(v ", goType, ") error {")
+	in := "v"
+	if encoding.base64 {
+		g.P("in, err := ", g.QualifiedGoIdent(base64Package.Ident("StdEncoding")), ".DecodeString(v)")
+		g.P("if err != nil {")
+		g.P("return ", fmtPackage.Ident("Errorf"), "(\"failed to decode ", fieldName, " field: %w\", err)")
+		g.P("}")
+		in = "in"
+	}
+	if single {
+		g.P("value := &", g.QualifiedGoIdent(field.Message.GoIdent), "{}")
+		g.P("if err := ", unmarshal, "(", in, ", value); err != nil {")
+		g.P("return ", fmtPackage.Ident("Errorf"), "(\"failed to unmarshal ", fieldName, " field: %w\", err)")
+		g.P("}")
+		g.P(fieldAssignment(message, field, "value"))
+	} else {
+		g.P("holder := &", messageName, "{}")
+		g.P("if err := ", unmarshal, "(", in, ", holder); err != nil {")
+		g.P("return ", fmtPackage.Ident("Errorf"), "(\"failed to unmarshal ", fieldName, " field: %w\", err)")
+		g.P("}")
+		g.P(fieldAssignment(message, field, fieldAccessOf("holder", message, field)))
+	}
+	g.P("return nil")
+	g.P("}")
+	g.P()
+}
+
+// protojsonOptions returns the protojson.MarshalOptions and protojson.UnmarshalOptions literals for the field,
+// partial allows messages missing required fields.
+func protojsonOptions(g *protogen.GeneratedFile, field *protogen.Field, partial bool) (string, string) {
+	opts := jsonOptions(field)
+	var marshalOpts, unmarshalOpts []string
+	if partial {
+		marshalOpts = append(marshalOpts, "AllowPartial: true")
+		unmarshalOpts = append(unmarshalOpts, "AllowPartial: true")
+	}
+	if opts.GetUseProtoNames() {
+		marshalOpts = append(marshalOpts, "UseProtoNames: true")
+	}
+	if opts.GetEmitUnpopulated() {
+		marshalOpts = append(marshalOpts, "EmitUnpopulated: true")
+	}
+	if opts.GetDiscardUnknown() {
+		unmarshalOpts = append(unmarshalOpts, "DiscardUnknown: true")
+	}
+	marshal := fmt.Sprintf("%s{%s}", g.QualifiedGoIdent(protojsonPackage.Ident("MarshalOptions")), strings.Join(marshalOpts, ", "))
+	unmarshal := fmt.Sprintf("%s{%s}", g.QualifiedGoIdent(protojsonPackage.Ident("UnmarshalOptions")), strings.Join(unmarshalOpts, ", "))
+	return marshal, unmarshal
+}
+
+// sqlEncoding returns the encoding of the database/sql wrapper of the field set through (go_options.field).sql.
+func sqlEncoding(field *protogen.Field) gooptions.SqlEncoding {
+	opts, _ := proto.GetExtension(field.Desc.Options(), gooptions.E_Field).(*gooptions.FieldOptions)
+	return opts.GetSql()
+}
+
+// validateSqlEncoding rejects the sql option on fields that are not a singular message outside a oneof.
+func validateSqlEncoding(field *protogen.Field) error {
+	if sqlEncoding(field) == gooptions.SqlEncoding_SQL_ENCODING_UNSPECIFIED {
+		return nil
+	}
+	if field.Desc.Kind() != protoreflect.MessageKind || field.Desc.IsList() || field.Desc.IsMap() ||
+		(field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()) {
+		return fmt.Errorf("%s: option (go_options.field).sql requires a singular message field outside a oneof", field.Desc.FullName())
+	}
+	return nil
+}
+
+// generateSqlWrapper generates the <Message><Field>SQL type implementing sql.Scanner and driver.Valuer
+// for the field and the <Field>SQL method returning it. NULL clears the field and an unset field is stored as NULL.
+func generateSqlWrapper(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field) {
+	fieldName := field.GoName
+	messageName := message.GoIdent.GoName
+	wrapperName := messageName + fieldName + "SQL"
+	binary := sqlEncoding(field) == gooptions.SqlEncoding_SQL_ENCODING_BINARY
+
+	marshal, unmarshal := g.QualifiedGoIdent(protoPackage.Ident("Marshal")), g.QualifiedGoIdent(protoPackage.Ident("Unmarshal"))
+	if !binary {
+		marshalOpts, unmarshalOpts := protojsonOptions(g, field, false)
+		marshal, unmarshal = marshalOpts+".Marshal", unmarshalOpts+".Unmarshal"
+	}
+
+	log(g, "generating sql wrapper for ", messageName, fieldName)
+	g.P(fmt.Sprintf("// %s stores the %s field of %s through database/sql.", wrapperName, fieldName, messageName))
+	g.P("type ", wrapperName, " struct {")
+	g.P("m *", messageName)
+	g.P("}")
+	g.P()
+	g.P(fmt.Sprintf("// %sSQL returns the %s field as a sql.Scanner and driver.Valuer.", fieldName, fieldName))
+	g.P("func (m *", messageName, ") ", fieldName, "SQL() ", wrapperName, " {")
+	g.P("return ", wrapperName, "{m: m}")
+	g.P("}")
+	g.P()
+	g.P("// Scan implements sql.Scanner, NULL clears the field.")
+	g.P("func (w ", wrapperName, ") Scan(src any) error {")
+	g.P("var v []byte")
+	g.P("switch src := src.(type) {")
+	g.P("case nil:")
+	if usesSetters(message) {
+		clear, _ := field.MethodName("Clear")
+		g.P("w.m.", clear, "()")
+	} else {
+		g.P(fieldAssignmentTo("w.m", message, field, "nil"))
+	}
+	g.P("return nil")
+	g.P("case []byte:")
+	g.P("v = src")
+	g.P("case string:")
+	g.P("v = []byte(src)")
+	g.P("default:")
+	g.P("return ", fmtPackage.Ident("Errorf"), "(\"cannot scan %T into ", fieldName, " field\", src)")
+	g.P("}")
+	g.P("value := &", g.QualifiedGoIdent(field.Message.GoIdent), "{}")
+	g.P("err := ", unmarshal, "(v, value)")
+	g.P("if err != nil {")
+	g.P("return ", fmtPackage.Ident("Errorf"), "(\"failed to unmarshal ", fieldName, " field: %w\", err)")
+	g.P("}")
+	g.P(fieldAssignmentTo("w.m", message, field, "value"))
+	g.P("return nil")
+	g.P("}")
+	g.P()
+	g.P("// Value implements driver.Valuer, an unset field is stored as NULL.")
+	g.P("func (w ", wrapperName, ") Value() (", g.QualifiedGoIdent(driverPackage.Ident("Value")), ", error) {")
+	if usesSetters(message) {
+		has, _ := field.MethodName("Has")
+		g.P("if !w.m.", has, "() {")
+	} else {
+		g.P("if ", fieldAccessOf("w.m", message, field), " == nil {")
+	}
+	g.P("return nil, nil")
+	g.P("}")
+	g.P("out, err := ", marshal, "(", fieldAccessOf("w.m", message, field), ")")
+	g.P("if err != nil {")
+	g.P("return nil, ", fmtPackage.Ident("Errorf"), "(\"failed to marshal ", fieldName, " field: %w\", err)")
+	g.P("}")
+	if binary {
+		g.P("return out, nil")
+	} else {
+		g.P("return string(out), nil")
+	}
+	g.P("}")
+	g.P()
+}
+
+// generateProtojsonMethods generates the JSON persistence methods using protojson.
+// Message fields are marshalled directly, other fields are marshalled as part of an otherwise empty
+// message so int64, enum and bytes values are encoded the way protojson does, that message may lack its required fields.
+func generateProtojsonMethods(g *protogen.GeneratedFile, message *protogen.Message, field *protogen.Field) {
+	fieldName := field.GoName
+	messageName := message.GoIdent.GoName
+	opts := jsonOptions(field)
+	single := field.Desc.Kind() == protoreflect.MessageKind && !field.Desc.IsList() && !field.Desc.IsMap()
+	marshal, unmarshal := protojsonOptions(g, field, !single)
+
+	log(g, "generating protojson methods for ", messageName, fieldName)
+	g.P(fmt.Sprintf("// Get%sAsJSON returns the %s field as a JSON byte slice encoded with protojson.", fieldName, fieldName))
+	g.P("func (m *", messageName, ") Get", fieldName, "AsJSON() ([]byte, error) {")
+	if single {
+		g.P("out, err := ", marshal, ".Marshal(", fieldAccess(message, field), ")")
+		g.P("if err != nil {")
+		g.P("return nil, ", fmtPackage.Ident("Errorf"), "(\"failed to marshal ", fieldName, " field: %w\", err)")
+		g.P("}")
+		g.P("return out, nil")
+	} else {
+		key := field.Desc.JSONName()
+		if opts.GetUseProtoNames() {
+			key = string(field.Desc.Name())
+		}
+		g.P("holder := &", messageName, "{}")
+		g.P(fieldAssignmentTo("holder", message, field, fieldAccess(message, field)))
+		g.P("out, err := ", marshal, ".Marshal(holder)")
+		g.P("if err != nil {")
+		g.P("return nil, ", fmtPackage.Ident("Errorf"), "(\"failed to marshal ", fieldName, " field: %w\", err)")
+		g.P("}")
+		g.P("var fields map[string]", jsonPackage.Ident("RawMessage"))
+		g.P("if err := ", jsonPackage.Ident("Unmarshal"), "(out, &fields); err != nil {")
+		g.P("return nil, ", fmtPackage.Ident("Errorf"), "(\"failed to marshal ", fieldName, " field: %w\", err)")
+		g.P("}")
+		g.P("if value, ok := fields[", strconv.Quote(key), "]; ok {")
+		g.P("return value, nil")
+		g.P("}")
+		g.P("return []byte(\"null\"), nil")
+	}
+	g.P("}")
+	g.P()
+	g.P(fmt.Sprintf("// Set%sFromJSON sets the %s field from a JSON byte slice decoded with protojson.", fieldName, fieldName))
+	g.P("func (m *", messageName, ") Set", fieldName, "FromJSON(v []byte) error {")
+	if single {
+		g.P("value := &", g.QualifiedGoIdent(field.Message.GoIdent), "{}")
+		g.P("err := ", unmarshal, ".Unmarshal(v, value)")
+		g.P("if err != nil {")
+		g.P("return ", fmtPackage.Ident("Errorf"), "(\"failed to unmarshal ", fieldName, " field: %w\", err)")
+		g.P("}")
+		g.P(fieldAssignment(message, field, "value"))
+	} else {
+		g.P("holder := &", messageName, "{}")
+		g.P("in := append(append([]byte(", strconv.Quote(fmt.Sprintf("{%q:", field.Desc.Name())), "), v...), '}')")
+		g.P("err := ", unmarshal, ".Unmarshal(in, holder)")
+		g.P("if err != nil {")
+		g.P("return ", fmtPackage.Ident("Errorf"), "(\"failed to unmarshal ", fieldName, " field: %w\", err)")
+		g.P("}")
+		g.P(fieldAssignment(message, field, fieldAccessOf("holder", message, field)))
+	}
+	g.P("return nil")
 	g.P("}")
+	g.P()
 }
 
 func protoHelperFunc(kind protoreflect.Kind) string {
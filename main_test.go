@@ -0,0 +1,604 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"maps"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime/debug"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	// internal_gengo has no compatibility promise, see gengoVersion
+	gengo "google.golang.org/protobuf/cmd/protoc-gen-go/internal_gengo"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	// register the well-known types imported by the testdata
+	_ "google.golang.org/protobuf/types/known/anypb"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/emptypb"
+	_ "google.golang.org/protobuf/types/known/fieldmaskpb"
+	_ "google.golang.org/protobuf/types/known/structpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	_ "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// goldenTests run the generator on a FileDescriptorProto from testdata and compare
+// the generated file with testdata/<name>.golden.
+var goldenTests = []struct {
+	name      string
+	input     string
+	parameter string
+}{
+	{name: "proto2", input: "proto2.textproto"},
+	{name: "proto2_protojson", input: "proto2.textproto", parameter: "protojson=true,json_use_proto_names=true"},
+	{name: "proto3", input: "proto3.textproto"},
+	{name: "editions", input: "editions.textproto"},
+	{name: "editions_opaque", input: "editions.textproto", parameter: "default_api_level=API_OPAQUE"},
+	{name: "editions_hybrid", input: "editions.textproto", parameter: "default_api_level=API_HYBRID"},
+	{name: "editions_opaque_error_options", input: "editions.textproto", parameter: "default_api_level=API_OPAQUE,error_options=true"},
+	{name: "parameters", input: "proto3.textproto", parameter: "option_prefix=Set,constructor_prefix=Make,suffix=.opts.go,disable_wkt=true,disable_runtime=true,builders=true"},
+	{name: "proto3_error_options", input: "proto3.textproto", parameter: "error_options=true"},
+	{name: "proto3_builders", input: "proto3.textproto", parameter: "builders=true"},
+	{name: "editions_opaque_builders", input: "editions.textproto", parameter: "default_api_level=API_OPAQUE,builders=true"},
+	{name: "wellknown", input: "wellknown.textproto"},
+	{name: "wellknown_opaque", input: "wellknown.textproto", parameter: "default_api_level=API_OPAQUE"},
+	{name: "googletype", input: "googletype.textproto"},
+	{name: "googletype_opaque_error_options", input: "googletype.textproto", parameter: "default_api_level=API_OPAQUE,error_options=true"},
+	{name: "protovalidate", input: "protovalidate.textproto"},
+	{name: "elements", input: "elements.textproto"},
+	{name: "builders_imports", input: "builders_imports.textproto", parameter: "builders=true"},
+	{name: "names", input: "names.textproto"},
+	{name: "names_qualified", input: "names.textproto", parameter: "qualified_names=true"},
+	{name: "protovalidate_numeric", input: "protovalidate_numeric.textproto"},
+	{name: "protovalidate_hook", input: "protovalidate.textproto", parameter: "default_api_level=API_OPAQUE,validate_func=google.golang.org/protobuf/proto.CheckInitialized"},
+}
+
+func TestGolden(t *testing.T) {
+	for _, tt := range goldenTests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := codeGeneratorRequest(t, tt.input, tt.parameter)
+			files := runPlugin(t, req, generate)
+			if len(files) != 1 {
+				t.Fatalf("generated %d files, want 1", len(files))
+			}
+
+			// type check the options together with the protoc-gen-go output of the same request
+			typeCheck(t, runPlugin(t, req, generateGo), files)
+
+			for _, content := range files {
+				golden := filepath.Join("testdata", tt.name+".golden")
+				if *update {
+					if err := os.WriteFile(golden, []byte(content), 0o644); err != nil {
+						t.Fatal(err)
+					}
+				}
+				want, err := os.ReadFile(golden)
+				if err != nil {
+					t.Fatalf("reading golden file, run go test -update to create it: %v", err)
+				}
+				if diff := cmp.Diff(string(want), content); diff != "" {
+					t.Errorf("generated code differs from %s (-want +got):\n%s", golden, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestParameters(t *testing.T) {
+	tests := []struct {
+		name      string
+		parameter string
+		wantErr   string
+	}{
+		{name: "unknown", parameter: "foo=bar", wantErr: `unknown parameter "foo"`},
+		{name: "invalid bool", parameter: "debug=maybe", wantErr: `invalid value "maybe" for parameter "debug"`},
+		{name: "suffix", parameter: "suffix=.txt", wantErr: "parameter suffix must end in .go"},
+		{name: "option prefix", parameter: "option_prefix=1With", wantErr: "parameter option_prefix must be a valid Go identifier"},
+		{name: "validate func", parameter: "validate_func=Validate", wantErr: "parameter validate_func must be an import path followed by a function name"},
+		{name: "absolute names lock", parameter: "names_lock=/tmp/options.lock", wantErr: "parameter names_lock must be a relative path without .."},
+		{name: "parent names lock", parameter: "names_lock=../options.lock", wantErr: "parameter names_lock must be a relative path without .."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetFlags()
+			req := codeGeneratorRequest(t, "proto3.textproto", tt.parameter)
+			gen, err := protogen.Options{ParamFunc: setParam}.New(req)
+			if err == nil {
+				err = generate(gen)
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNamesLock(t *testing.T) {
+	// protoc runs from the output root, so the lock is read and written at the same relative path
+	const lock = "locks/options.lock"
+	request := codeGeneratorRequest(t, "proto3.textproto", "names_lock="+lock)
+	chdir(t, t.TempDir())
+	writeLock := func(t *testing.T, content string) {
+		t.Helper()
+		if err := os.MkdirAll(filepath.Dir(lock), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(lock, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t.Run("written", func(t *testing.T) {
+		files := runPlugin(t, request, generate)
+		if !strings.Contains(files[lock], "field golden.proto3.Task.title qualified\n") {
+			t.Fatalf("names lock is not written to %s:\n%s", lock, files[lock])
+		}
+
+		// the next run reads the lock protoc wrote to the output directory
+		writeLock(t, strings.Replace(files[lock], "field golden.proto3.Task.title qualified", "field golden.proto3.Task.title unqualified", 1))
+		files = runPlugin(t, request, generate)
+		if !strings.Contains(files["proto3/proto3_options.go"], "func WithTitle(") {
+			t.Error("the lock written by the previous run is not read")
+		}
+	})
+
+	t.Run("locked", func(t *testing.T) {
+		// Task.title was issued before Project added a title field
+		writeLock(t, "# issued names\nfield golden.proto3.Task.title unqualified\nfield golden.removed.Old.name qualified\n")
+		files := runPlugin(t, request, generate)
+		code := files["proto3/proto3_options.go"]
+		for _, want := range []string{"func WithTitle(", "func WithTitleForProject("} {
+			if !strings.Contains(code, want) {
+				t.Errorf("generated code does not contain %q", want)
+			}
+		}
+		for _, want := range []string{
+			"field golden.proto3.Task.title unqualified\n",
+			"field golden.proto3.Project.title qualified\n",
+			"oneof golden.proto3.Task.target unqualified\n",
+			"field golden.removed.Old.name qualified\n",
+		} {
+			if !strings.Contains(files[lock], want) {
+				t.Errorf("names lock does not contain %q:\n%s", want, files[lock])
+			}
+		}
+	})
+
+	tests := []struct {
+		name    string
+		lock    string
+		wantErr string
+	}{
+		{name: "duplicate", lock: "field golden.proto3.Task.title unqualified\nfield golden.proto3.Project.title unqualified\n", wantErr: "both generate the option names for Title"},
+		{name: "invalid", lock: "field golden.proto3.Task.title\n", wantErr: `:1: invalid names lock entry "field golden.proto3.Task.title"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writeLock(t, tt.lock)
+			resetFlags()
+			gen, err := protogen.Options{ParamFunc: setParam}.New(request)
+			if err == nil {
+				err = generate(gen)
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestEnumValueNameCollision checks that an enum value option colliding with a field option of the same message,
+// which qualifying with the message name cannot resolve, is reported.
+func TestEnumValueNameCollision(t *testing.T) {
+	request := codeGeneratorRequest(t, "names.textproto", "")
+	for _, file := range request.ProtoFile {
+		if file.GetName() != "names/names.proto" {
+			continue
+		}
+		user := file.GetMessageType()[0]
+		user.Field = append(user.Field, &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String("mode_fast"),
+			Number: proto.Int32(6),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:   descriptorpb.FieldDescriptorProto_TYPE_BOOL.Enum(),
+		})
+	}
+
+	resetFlags()
+	gen, err := protogen.Options{ParamFunc: setParam}.New(request)
+	if err == nil {
+		err = generate(gen)
+	}
+	want := "enum value golden.names.MODE_FAST of field golden.names.User.mode and field golden.names.User.mode_fast both generate the option names for ModeFastForUser"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+}
+
+// chdir changes the working directory until the end of the test,
+// the testdata is read relative to the package directory so it has to be read before.
+func chdir(t *testing.T, dir string) {
+	t.Helper()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := os.Chdir(wd); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestValidateRuntime(t *testing.T) {
+	t.Run("protovalidate", func(t *testing.T) {
+		runGenerated(t, "protovalidate.textproto", "", "protovalidate_runtime_test.go")
+	})
+	t.Run("numeric", func(t *testing.T) {
+		runGenerated(t, "protovalidate_numeric.textproto", "", "protovalidate_numeric_runtime_test.go")
+	})
+}
+
+func TestProto2Runtime(t *testing.T) {
+	runGenerated(t, "proto2.textproto", "protojson=true", "proto2_runtime_test.go")
+}
+
+func TestGoogleTypeRuntime(t *testing.T) {
+	runGenerated(t, "googletype.textproto", "error_options=true", "googletype_runtime_test.go")
+}
+
+// testdataImports maps the imports of the testdata that are not linked into the test binary
+// to the FileDescriptorProto in testdata declaring them.
+var testdataImports = map[string]string{
+	"buf/validate/validate.proto": "buf_validate.textproto",
+	"google/type/color.proto":     "google_type_color.textproto",
+	"google/type/date.proto":      "google_type_date.textproto",
+	"google/type/datetime.proto":  "google_type_datetime.textproto",
+	"google/type/decimal.proto":   "google_type_decimal.textproto",
+	"google/type/interval.proto":  "google_type_interval.textproto",
+	"google/type/latlng.proto":    "google_type_latlng.textproto",
+	"google/type/money.proto":     "google_type_money.textproto",
+	"google/type/timeofday.proto": "google_type_timeofday.textproto",
+	"proto3/proto3.proto":         "proto3.textproto",
+}
+
+// testdataRegistry resolves the testdata imports before falling back to the global registries.
+type testdataRegistry struct {
+	files *protoregistry.Files
+	types *protoregistry.Types
+}
+
+// loadTestdataImports compiles the testdata imports, they may only import files of the global registry.
+func loadTestdataImports(t *testing.T) testdataRegistry {
+	t.Helper()
+	r := testdataRegistry{files: new(protoregistry.Files), types: new(protoregistry.Types)}
+	for _, input := range testdataImports {
+		fdp := readFileDescriptorProto(t, input, protoregistry.GlobalTypes)
+		fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+		if err != nil {
+			t.Fatalf("compiling %s: %v", input, err)
+		}
+		if err := r.files.RegisterFile(fd); err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < fd.Extensions().Len(); i++ {
+			if err := r.types.RegisterExtension(dynamicpb.NewExtensionType(fd.Extensions().Get(i))); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	return r
+}
+
+func (r testdataRegistry) FindFileByPath(path string) (protoreflect.FileDescriptor, error) {
+	if fd, err := r.files.FindFileByPath(path); err == nil {
+		return fd, nil
+	}
+	return protoregistry.GlobalFiles.FindFileByPath(path)
+}
+
+func (r testdataRegistry) FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	if d, err := r.files.FindDescriptorByName(name); err == nil {
+		return d, nil
+	}
+	return protoregistry.GlobalFiles.FindDescriptorByName(name)
+}
+
+func (r testdataRegistry) FindExtensionByName(field protoreflect.FullName) (protoreflect.ExtensionType, error) {
+	if xt, err := r.types.FindExtensionByName(field); err == nil {
+		return xt, nil
+	}
+	return protoregistry.GlobalTypes.FindExtensionByName(field)
+}
+
+func (r testdataRegistry) FindExtensionByNumber(message protoreflect.FullName, field protoreflect.FieldNumber) (protoreflect.ExtensionType, error) {
+	if xt, err := r.types.FindExtensionByNumber(message, field); err == nil {
+		return xt, nil
+	}
+	return protoregistry.GlobalTypes.FindExtensionByNumber(message, field)
+}
+
+func (r testdataRegistry) FindMessageByName(message protoreflect.FullName) (protoreflect.MessageType, error) {
+	return protoregistry.GlobalTypes.FindMessageByName(message)
+}
+
+func (r testdataRegistry) FindMessageByURL(url string) (protoreflect.MessageType, error) {
+	return protoregistry.GlobalTypes.FindMessageByURL(url)
+}
+
+// readFileDescriptorProto parses the FileDescriptorProto in testdata, resolving the extensions in its options.
+func readFileDescriptorProto(t *testing.T, input string, resolver interface {
+	protoregistry.ExtensionTypeResolver
+	protoregistry.MessageTypeResolver
+}) *descriptorpb.FileDescriptorProto {
+	t.Helper()
+	b, err := os.ReadFile(filepath.Join("testdata", input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fdp := &descriptorpb.FileDescriptorProto{}
+	if err := (prototext.UnmarshalOptions{Resolver: resolver}).Unmarshal(b, fdp); err != nil {
+		t.Fatalf("parsing %s: %v", input, err)
+	}
+	return fdp
+}
+
+// codeGeneratorRequest builds the request protoc would send for the FileDescriptorProto in testdata.
+// The descriptor is validated with protodesc and its imports are resolved from the testdata imports
+// and the global registry.
+func codeGeneratorRequest(t *testing.T, input string, parameter string) *pluginpb.CodeGeneratorRequest {
+	t.Helper()
+	registry := loadTestdataImports(t)
+	fdp := readFileDescriptorProto(t, input, registry)
+	if _, err := protodesc.NewFile(fdp, registry); err != nil {
+		t.Fatalf("compiling %s: %v", input, err)
+	}
+
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate:        []string{fdp.GetName()},
+		Parameter:             proto.String(strings.Trim("paths=source_relative,"+parameter, ",")),
+		SourceFileDescriptors: []*descriptorpb.FileDescriptorProto{fdp},
+	}
+	seen := make(map[string]bool)
+	var addDependencies func(deps []string)
+	addDependencies = func(deps []string) {
+		for _, dep := range deps {
+			if seen[dep] {
+				continue
+			}
+			seen[dep] = true
+			fd, err := registry.FindFileByPath(dep)
+			if err != nil {
+				t.Fatalf("resolving import %s: %v", dep, err)
+			}
+			depProto := protodesc.ToFileDescriptorProto(fd)
+			addDependencies(depProto.GetDependency())
+			req.ProtoFile = append(req.ProtoFile, depProto)
+		}
+	}
+	addDependencies(fdp.GetDependency())
+	req.ProtoFile = append(req.ProtoFile, fdp)
+	return req
+}
+
+// runPlugin runs the plugin function on the request and returns the generated files by name.
+func runPlugin(t *testing.T, req *pluginpb.CodeGeneratorRequest, f func(*protogen.Plugin) error) map[string]string {
+	t.Helper()
+	resetFlags()
+	gen, err := protogen.Options{ParamFunc: setParam}.New(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f(gen); err != nil {
+		t.Fatal(err)
+	}
+	resp := gen.Response()
+	if resp.Error != nil {
+		t.Fatal(resp.GetError())
+	}
+	files := make(map[string]string)
+	for _, file := range resp.File {
+		files[file.GetName()] = file.GetContent()
+	}
+	return files
+}
+
+// gengoVersion is the google.golang.org/protobuf version providing internal_gengo. The package is the generator of
+// protoc-gen-go, exported for the protobuf module's own tools without a compatibility promise, so it is pinned:
+// after raising the requirement in go.mod check that generateGo still builds, regenerate the golden files and update the version.
+const gengoVersion = "v1.36.3"
+
+// TestGengoVersion fails when go.mod requires a protobuf version other than the one internal_gengo was pinned at.
+func TestGengoVersion(t *testing.T) {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		t.Skip("no build information")
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == "google.golang.org/protobuf" && dep.Version != gengoVersion {
+			t.Errorf("google.golang.org/protobuf is %s, internal_gengo is pinned at %s", dep.Version, gengoVersion)
+		}
+	}
+}
+
+// generateGo generates the protoc-gen-go output the options are compiled against.
+func generateGo(gen *protogen.Plugin) error {
+	gen.SupportedFeatures = gengo.SupportedFeatures
+	gengo.GenerateVersionMarkers = false
+	for _, file := range gen.Files {
+		if file.Generate {
+			gengo.GenerateFile(gen, file)
+		}
+	}
+	return nil
+}
+
+var (
+	// fset and sourceImporter are shared by all type checks so imported packages are only loaded once
+	fset           = token.NewFileSet()
+	sourceImporter = importer.ForCompiler(fset, "source", nil)
+)
+
+// testdataPackages caches the packages type checked by the testdataImporter.
+var testdataPackages = make(map[string]*types.Package)
+
+// testdataImporter type checks the protoc-gen-go output of the testdata imports, together with
+// the options generated for the testdata packages, every other package is loaded by the sourceImporter.
+type testdataImporter struct {
+	t *testing.T
+}
+
+func (imp testdataImporter) Import(path string) (*types.Package, error) {
+	if pkg, ok := testdataPackages[path]; ok {
+		return pkg, nil
+	}
+	for _, input := range testdataImports {
+		req := codeGeneratorRequest(imp.t, input, "")
+		if requestImportPath(req) != path {
+			continue
+		}
+		fileSets := []map[string]string{runPlugin(imp.t, req, generateGo)}
+		// the testdata packages have generated options, the other imports stand in for third party packages
+		if strings.HasPrefix(path, "example.com/golden/") {
+			fileSets = append(fileSets, runPlugin(imp.t, req, generate))
+		}
+		pkg, err := checkPackage(imp.t, fileSets...)
+		if err != nil {
+			return nil, err
+		}
+		testdataPackages[path] = pkg
+		return pkg, nil
+	}
+	return sourceImporter.Import(path)
+}
+
+// typeCheck parses all files as a single package and runs the go/types checker on it.
+func typeCheck(t *testing.T, fileSets ...map[string]string) {
+	t.Helper()
+	if _, err := checkPackage(t, fileSets...); err != nil {
+		t.Errorf("type checking generated code: %v", err)
+	}
+}
+
+// checkPackage type checks the files as a single package, importing the testdata imports.
+func checkPackage(t *testing.T, fileSets ...map[string]string) (*types.Package, error) {
+	t.Helper()
+	var files []*ast.File
+	for _, fileSet := range fileSets {
+		for name, content := range fileSet {
+			// the Hybrid API also generates the Opaque variant behind the protoopaque build tag
+			if strings.HasSuffix(name, "_protoopaque.pb.go") {
+				continue
+			}
+			f, err := parser.ParseFile(fset, name, content, 0)
+			if err != nil {
+				t.Fatalf("parsing generated code: %v", err)
+			}
+			files = append(files, f)
+		}
+	}
+	conf := types.Config{Importer: testdataImporter{t}}
+	return conf.Check(files[0].Name.Name, fset, files, nil)
+}
+
+// requestImportPath returns the Go import path of the file to generate in the request.
+func requestImportPath(req *pluginpb.CodeGeneratorRequest) string {
+	goPackage := req.SourceFileDescriptors[0].GetOptions().GetGoPackage()
+	if i := strings.Index(goPackage, ";"); i >= 0 {
+		goPackage = goPackage[:i]
+	}
+	return goPackage
+}
+
+// runGenerated runs the Go test file from testdata against the code generated for the input.
+// The protoc-gen-go and options output is written to a temporary module next to the test file,
+// every testdata import becomes a module of its own and the options runtime is replaced by this repository.
+func runGenerated(t *testing.T, input, parameter, testFile string) {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("runs the go tool")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go tool not found")
+	}
+	root, err := filepath.Abs(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum, err := os.ReadFile("go.sum")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	write := func(dir string, files map[string]string) {
+		t.Helper()
+		for name, content := range files {
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(filepath.Join(dir, filepath.Base(name)), []byte(content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	goMod := "module example.com/golden\n\ngo 1.23.4\n\nrequire github.com/terwey/protoc-gen-go-options v0.0.0\n\nreplace github.com/terwey/protoc-gen-go-options => " + root + "\n"
+	for _, dep := range slices.Sorted(maps.Values(testdataImports)) {
+		req := codeGeneratorRequest(t, dep, "")
+		depDir := filepath.Join(dir, "deps", strings.TrimSuffix(dep, ".textproto"))
+		write(depDir, runPlugin(t, req, generateGo))
+		write(depDir, map[string]string{"go.mod": "module " + requestImportPath(req) + "\n\ngo 1.23.4\n"})
+		goMod += fmt.Sprintf("require %s v0.0.0\nreplace %[1]s => %s\n", requestImportPath(req), depDir)
+	}
+	write(dir, map[string]string{"go.mod": goMod, "go.sum": string(sum)})
+
+	req := codeGeneratorRequest(t, input, parameter)
+	pkgDir := filepath.Join(dir, strings.TrimPrefix(requestImportPath(req), "example.com/golden/"))
+	write(pkgDir, runPlugin(t, req, generateGo))
+	write(pkgDir, runPlugin(t, req, generate))
+	test, err := os.ReadFile(filepath.Join("testdata", testFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	write(pkgDir, map[string]string{testFile: string(test)})
+
+	cmd := exec.Command(goTool, "test", "./...")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off", "GOWORK=off")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go test of the generated code: %v\n%s", err, out)
+	}
+}
+
+// resetFlags restores the default value of every plugin parameter.
+func resetFlags() {
+	flags.VisitAll(func(f *flag.Flag) {
+		f.Value.Set(f.DefValue)
+	})
+}
@@ -0,0 +1,44 @@
+// Package options is the runtime of the code generated by protoc-gen-go-options.
+//
+// Every generated [Message]Option is an alias of Option instantiated with the message,
+// so helpers written against Option work with the options of every generated message:
+//
+//	msg := example.NewBasicMessage(
+//		options.When(verbose, example.WithIsActive(true)),
+//		options.Compose(defaults...),
+//	)
+package options
+
+import "google.golang.org/protobuf/proto"
+
+// Option is a functional option for the message T, e.g. Option[*example.BasicMessage].
+type Option[T proto.Message] func(T)
+
+// Apply applies the options to m in order and returns m.
+func Apply[T proto.Message](m T, opts ...Option[T]) T {
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CloneWith returns a copy of m made by proto.Clone with the options applied, m itself is not modified.
+func CloneWith[T proto.Message](m T, opts ...Option[T]) T {
+	return Apply(proto.Clone(m).(T), opts...)
+}
+
+// Compose returns a single option applying the options in order.
+func Compose[T proto.Message](opts ...Option[T]) Option[T] {
+	opts = append([]Option[T](nil), opts...)
+	return func(m T) {
+		Apply(m, opts...)
+	}
+}
+
+// When returns opt when cond is true and an option that does nothing otherwise.
+func When[T proto.Message](cond bool, opt Option[T]) Option[T] {
+	if cond {
+		return opt
+	}
+	return func(T) {}
+}
@@ -0,0 +1,50 @@
+package options
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func appendValue(s string) Option[*wrapperspb.StringValue] {
+	return func(m *wrapperspb.StringValue) {
+		m.Value += s
+	}
+}
+
+func TestApply(t *testing.T) {
+	m := Apply(&wrapperspb.StringValue{}, appendValue("a"), appendValue("b"))
+	if m.GetValue() != "ab" {
+		t.Errorf("got %q, want the options applied in order", m.GetValue())
+	}
+}
+
+func TestCompose(t *testing.T) {
+	opts := []Option[*wrapperspb.StringValue]{appendValue("a"), appendValue("b")}
+	composed := Compose(opts...)
+	// changing the slice afterwards does not change the composed option
+	opts[0] = appendValue("x")
+
+	m := Apply(&wrapperspb.StringValue{}, composed, appendValue("c"), composed)
+	if m.GetValue() != "abcab" {
+		t.Errorf("got %q, want abcab", m.GetValue())
+	}
+	if m := Apply(&wrapperspb.StringValue{}, Compose[*wrapperspb.StringValue]()); m.GetValue() != "" {
+		t.Errorf("got %q from an empty composition", m.GetValue())
+	}
+}
+
+func TestWhen(t *testing.T) {
+	m := Apply(&wrapperspb.StringValue{}, When(true, appendValue("a")), When(false, appendValue("b")))
+	if m.GetValue() != "a" {
+		t.Errorf("got %q, want only the option with a true condition", m.GetValue())
+	}
+}
+
+func TestCloneWith(t *testing.T) {
+	original := &wrapperspb.StringValue{Value: "a"}
+	c := CloneWith(original, appendValue("b"))
+	if c.GetValue() != "ab" || original.GetValue() != "a" {
+		t.Errorf("got copy %q and original %q, want ab and a", c.GetValue(), original.GetValue())
+	}
+}
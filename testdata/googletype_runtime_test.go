@@ -0,0 +1,121 @@
+package googletype
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMoneyFromString(t *testing.T) {
+	tests := []struct {
+		amount  string
+		units   int64
+		nanos   int32
+		wantErr string
+	}{
+		{amount: "12.34", units: 12, nanos: 340000000},
+		{amount: "-1.5", units: -1, nanos: -500000000},
+		{amount: "-0.25", units: 0, nanos: -250000000},
+		{amount: "0.000000001", units: 0, nanos: 1},
+		{amount: "1e3", units: 1000, nanos: 0},
+		{amount: "1.0000000001", wantErr: "more than nine decimal places"},
+		{amount: "-0.0000000005", wantErr: "more than nine decimal places"},
+		{amount: "twelve", wantErr: "invalid amount"},
+		{amount: "9223372036854775808", wantErr: "out of range"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.amount, func(t *testing.T) {
+			opt, err := WithNewPriceForOrderFromString("EUR", tt.amount)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			price := NewOrder(opt).GetPrice()
+			if price.GetCurrencyCode() != "EUR" || price.GetUnits() != tt.units || price.GetNanos() != tt.nanos {
+				t.Errorf("got %v, want %d units and %d nanos", price, tt.units, tt.nanos)
+			}
+		})
+	}
+}
+
+func TestGoogleTypeErrorOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		opt     OrderOptionE
+		wantErr string
+	}{
+		{name: "price", opt: WithPriceE("EUR", -1, -500000000)},
+		{name: "price nanos range", opt: WithPriceE("EUR", 1, 1e9), wantErr: "nanos 1000000000 out of range"},
+		{name: "price signs", opt: WithPriceE("EUR", 1, -1), wantErr: "have different signs"},
+		{name: "location bounds", opt: WithLocationE(-90, 180)},
+		{name: "location latitude", opt: WithLocationE(90.5, 0), wantErr: "latitude 90.5 out of range"},
+		{name: "location longitude", opt: WithLocationE(0, -180.5), wantErr: "longitude -180.5 out of range"},
+		{name: "location NaN", opt: WithLocationE(math.NaN(), 0), wantErr: "latitude NaN out of range"},
+		{name: "delivery", opt: WithDeliveryE(time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC))},
+		{name: "delivery year zero", opt: WithDeliveryE(time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC)), wantErr: "year 0 out of range"},
+		{name: "delivery year 10000", opt: WithDeliveryE(time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC)), wantErr: "year 10000 out of range"},
+		{name: "placed", opt: WithPlacedE(time.Date(9999, 12, 31, 23, 0, 0, 0, time.UTC), nil)},
+		{name: "placed without year", opt: WithPlacedE(time.Date(0, 6, 1, 0, 0, 0, 0, time.UTC), time.UTC)},
+		{name: "placed year 10000", opt: WithPlacedE(time.Date(9999, 12, 31, 23, 0, 0, 0, time.UTC), time.FixedZone("UTC+2", 2*3600)), wantErr: "year 10000 out of range"},
+		{name: "placed negative year", opt: WithPlacedE(time.Date(-1, 1, 1, 0, 0, 0, 0, time.UTC), nil), wantErr: "year -1 out of range"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewOrderE(tt.opt)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("got error %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDateTimeLocation(t *testing.T) {
+	v := time.Date(2024, 3, 1, 12, 30, 0, 0, time.FixedZone("CET", 3600))
+
+	placed := NewOrder(WithNewPlacedForOrder(v, time.UTC)).GetPlaced()
+	if placed.GetTimeZone().GetId() != "UTC" || placed.GetHours() != 11 {
+		t.Errorf("time.UTC got %v", placed)
+	}
+
+	placed = NewOrder(WithNewPlacedForOrder(v, nil)).GetPlaced()
+	if placed.GetUtcOffset().AsDuration() != time.Hour || placed.GetHours() != 12 {
+		t.Errorf("nil location got %v", placed)
+	}
+
+	// time.Local is named "Local", which is not an IANA time zone, so the offset is stored
+	placed = NewOrder(WithNewPlacedForOrder(v, time.Local)).GetPlaced()
+	_, offset := v.In(time.Local).Zone()
+	if placed.GetTimeZone() != nil || placed.GetUtcOffset().AsDuration() != time.Duration(offset)*time.Second {
+		t.Errorf("time.Local got %v", placed)
+	}
+	if placed.GetHours() != int32(v.In(time.Local).Hour()) {
+		t.Errorf("time.Local got hour %d, want %d", placed.GetHours(), v.In(time.Local).Hour())
+	}
+
+	// a fixed zone is named by the caller, its name is not an IANA time zone so the offset is stored
+	placed = NewOrder(WithNewPlacedForOrder(v, time.FixedZone("UTC+2", 2*3600))).GetPlaced()
+	if placed.GetTimeZone() != nil || placed.GetUtcOffset().AsDuration() != 2*time.Hour || placed.GetHours() != 13 {
+		t.Errorf("fixed zone got %v", placed)
+	}
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("no time zone database: %v", err)
+	}
+	placed = NewOrder(WithNewPlacedForOrder(v, berlin)).GetPlaced()
+	if placed.GetTimeZone().GetId() != "Europe/Berlin" || placed.GetHours() != 12 {
+		t.Errorf("Europe/Berlin got %v", placed)
+	}
+}
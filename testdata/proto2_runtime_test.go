@@ -0,0 +1,74 @@
+package proto2
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestPersistenceWithoutRequiredFields(t *testing.T) {
+	history := []*Account_Credentials{{User: proto.String("alice")}, {Token: proto.String("partial")}}
+	tests := []struct {
+		name string
+		get  func(m *Account) (any, error)
+		set  func(m *Account, v any) error
+	}{
+		{
+			name: "JSON",
+			get:  func(m *Account) (any, error) { return m.GetHistoryAsJSON() },
+			set:  func(m *Account, v any) error { return m.SetHistoryFromJSON(v.([]byte)) },
+		},
+		{
+			name: "Binary",
+			get:  func(m *Account) (any, error) { return m.GetHistoryAsBinary() },
+			set:  func(m *Account, v any) error { return m.SetHistoryFromBinary(v.([]byte)) },
+		},
+		{
+			name: "Text",
+			get:  func(m *Account) (any, error) { return m.GetHistoryAsText() },
+			set:  func(m *Account, v any) error { return m.SetHistoryFromText(v.([]byte)) },
+		},
+		{
+			name: "Base64",
+			get:  func(m *Account) (any, error) { return m.GetHistoryAsBase64() },
+			set:  func(m *Account, v any) error { return m.SetHistoryFromBase64(v.(string)) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := tt.get(&Account{Id: proto.String("account"), History: history})
+			if err != nil {
+				t.Fatalf("Get: %v", err)
+			}
+			got := &Account{}
+			if err := tt.set(got, out); err != nil {
+				t.Fatalf("Set: %v", err)
+			}
+			if !proto.Equal(got, &Account{History: history}) {
+				t.Errorf("round trip = %v, want history %v", got, history)
+			}
+		})
+	}
+}
+
+func TestCheckedConstructorForeignMessage(t *testing.T) {
+	schema := func(name *descriptorpb.UninterpretedOption_NamePart) *descriptorpb.FileDescriptorSet {
+		return &descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{{
+			Options: &descriptorpb.FileOptions{UninterpretedOption: []*descriptorpb.UninterpretedOption{{
+				Name: []*descriptorpb.UninterpretedOption_NamePart{name},
+			}}},
+		}}}
+	}
+
+	complete := schema(&descriptorpb.UninterpretedOption_NamePart{NamePart: proto.String("opt"), IsExtension: proto.Bool(false)})
+	if _, err := NewAccountChecked(WithId("account"), WithSchema(complete)); err != nil {
+		t.Errorf("NewAccountChecked with a complete schema: %v", err)
+	}
+
+	_, err := NewAccountChecked(WithId("account"), WithSchema(schema(&descriptorpb.UninterpretedOption_NamePart{})))
+	if err == nil || !strings.HasPrefix(err.Error(), "schema: ") {
+		t.Errorf("NewAccountChecked with an incomplete schema = %v, want a schema error", err)
+	}
+}
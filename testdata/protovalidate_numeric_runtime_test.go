@@ -0,0 +1,34 @@
+package protovalidate_numeric
+
+import "testing"
+
+func TestValidateReading(t *testing.T) {
+	tests := []struct {
+		name    string
+		reading *Reading
+		wantErr string
+	}{
+		{name: "valid", reading: &Reading{Celsius: -273, Drift: 0.75, Port: 1024}},
+		{name: "valid below the exclusive range", reading: &Reading{Drift: -0.75, Port: 49151}},
+		{name: "celsius gte", reading: &Reading{Celsius: -274, Drift: 1, Port: 8080}, wantErr: "celsius: value must be greater than or equal to -273"},
+		{name: "drift upper edge", reading: &Reading{Drift: 0.5, Port: 8080}, wantErr: "drift: value must be greater than 0.5 or less than -0.5"},
+		{name: "drift lower edge", reading: &Reading{Drift: -0.5, Port: 8080}, wantErr: "drift: value must be greater than 0.5 or less than -0.5"},
+		{name: "drift inside", reading: &Reading{Drift: 0, Port: 8080}, wantErr: "drift: value must be greater than 0.5 or less than -0.5"},
+		{name: "port lte", reading: &Reading{Drift: 1, Port: 49152}, wantErr: "port: value must be less than or equal to 49151"},
+		{name: "port gte", reading: &Reading{Drift: 1, Port: 80}, wantErr: "port: value must be greater than or equal to 1024"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateReading(tt.reading)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("got error %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
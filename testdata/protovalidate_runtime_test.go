@@ -0,0 +1,80 @@
+package protovalidate
+
+import "testing"
+
+// validUser returns a User passing every rule, each test case breaks a single rule.
+func validUser() *User {
+	return &User{
+		Name:    "ada",
+		Email:   "ada@example.com",
+		Age:     36,
+		Status:  Status_STATUS_OPEN,
+		Tags:    []string{"admin"},
+		Ratio:   0.5,
+		Address: &Address{City: "London"},
+		Contact: &User_Phone{Phone: "+44"},
+	}
+}
+
+func TestValidateUser(t *testing.T) {
+	level := func(v uint32) *uint32 { return &v }
+	tests := []struct {
+		name    string
+		modify  func(u *User)
+		wantErr string
+	}{
+		{name: "valid", modify: func(u *User) {}},
+		{name: "valid bounds", modify: func(u *User) { u.Age = 150; u.Level = level(3); u.Name = "añø" }},
+		{name: "valid handle", modify: func(u *User) { u.Contact = &User_Handle{Handle: "ada"} }},
+		{name: "name required", modify: func(u *User) { u.Name = "" }, wantErr: "name: value is required"},
+		{name: "name runes", modify: func(u *User) { u.Name = "añ" }, wantErr: "name: value length must be at least 3 characters"},
+		{name: "name too long", modify: func(u *User) { u.Name = string(make([]byte, 65)) }, wantErr: "name: value length must be at most 64 characters"},
+		{name: "email contains", modify: func(u *User) { u.Email = "ada" }, wantErr: `email: value does not contain substring "@"`},
+		{name: "email not in", modify: func(u *User) { u.Email = "root@localhost" }, wantErr: `email: value must not be in list ["root@localhost"]`},
+		{name: "age lte", modify: func(u *User) { u.Age = 151 }, wantErr: "age: value must be less than or equal to 150"},
+		{name: "age gte", modify: func(u *User) { u.Age = -1 }, wantErr: "age: value must be greater than or equal to 0"},
+		{name: "level in", modify: func(u *User) { u.Level = level(0) }, wantErr: "level: value must be in list [1, 2, 3]"},
+		{name: "status defined", modify: func(u *User) { u.Status = 7 }, wantErr: "status: value must be one of the defined enum values"},
+		{name: "status not in", modify: func(u *User) { u.Status = Status_STATUS_UNSPECIFIED }, wantErr: "status: value must not be in list [0]"},
+		{name: "tags min items", modify: func(u *User) { u.Tags = nil }, wantErr: "tags: value must contain at least 1 item(s)"},
+		{name: "tags max items", modify: func(u *User) { u.Tags = make([]string, 6) }, wantErr: "tags: value must contain no more than 5 item(s)"},
+		{name: "avatar max len", modify: func(u *User) { u.Avatar = make([]byte, 1025) }, wantErr: "avatar: value must be at most 1024 bytes"},
+		{name: "ratio lt", modify: func(u *User) { u.Ratio = 1 }, wantErr: "ratio: value must be less than 1"},
+		{name: "ratio gt", modify: func(u *User) { u.Ratio = 0 }, wantErr: "ratio: value must be greater than 0"},
+		{name: "address required", modify: func(u *User) { u.Address = nil }, wantErr: "address: value is required"},
+		{name: "address nested", modify: func(u *User) { u.Address.City = "" }, wantErr: "address: city: value length must be at least 1 characters"},
+		{name: "previous nested", modify: func(u *User) { u.Previous = []*Address{{City: "Paris"}, {}} }, wantErr: "previous[1]: city: value length must be at least 1 characters"},
+		{name: "phone prefix", modify: func(u *User) { u.Contact = &User_Phone{Phone: "44"} }, wantErr: `phone: value does not have prefix "+"`},
+		{name: "contact required", modify: func(u *User) { u.Contact = nil }, wantErr: "contact: exactly one field is required in oneof"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := validUser()
+			tt.modify(u)
+			err := ValidateUser(u)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateUser() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("ValidateUser() = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewUserValidated(t *testing.T) {
+	if _, err := NewUserValidated(WithName("ada")); err == nil {
+		t.Error("NewUserValidated accepted a user breaking the rules")
+	}
+	u, err := NewUserValidated(WithName("ada"), WithEmail("ada@example.com"), WithStatus(Status_STATUS_OPEN),
+		WithTags("admin"), WithRatio(0.5), WithNewAddressForUser(WithCity("London")), WithPhone("+44"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.GetName() != "ada" {
+		t.Errorf("NewUserValidated got %v", u)
+	}
+}